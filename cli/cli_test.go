@@ -0,0 +1,245 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit/mfxkittest"
+	mfxkitsdk "github.com/mainflux/mfxkit/pkg/sdk/go"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// execute runs the command line using the given SDK, secret and output
+// format, and returns the command output.
+func execute(s mfxkitsdk.SDK, secret, format string, args ...string) (string, error) {
+	Secret, Format = secret, format
+	Limit, Offset, Revision = 0, 0, 0
+	Name, Order, Dir, Metadata = "", "", "", ""
+	SetSDK(s)
+
+	var buf bytes.Buffer
+	out = &buf
+
+	root := &cobra.Command{
+		Use:           "mfxkit-cli",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	root.AddCommand(NewPingCmd())
+	root.AddCommand(NewHealthCmd())
+	root.AddCommand(NewVersionCmd())
+	root.AddCommand(NewKitsCmd())
+	root.SetArgs(args)
+
+	err := root.Execute()
+	return buf.String(), err
+}
+
+func createKit(t *testing.T, ts *mfxkittest.Server, kit string) mfxkitsdk.Kit {
+	res, err := execute(ts.SDK, mfxkittest.DefSecret, JSONFormat, "kits", "create", kit)
+	require.Nil(t, err, fmt.Sprintf("create kit: unexpected error: %s", err))
+
+	var k mfxkitsdk.Kit
+	err = json.Unmarshal([]byte(res), &k)
+	require.Nil(t, err, fmt.Sprintf("create kit: unexpected error: %s", err))
+
+	return k
+}
+
+func TestPing(t *testing.T) {
+	ts := mfxkittest.NewServer(mfxkittest.Options{})
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		secret string
+		format string
+		out    string
+		err    error
+	}{
+		{
+			desc:   "ping with table format",
+			secret: mfxkittest.DefSecret,
+			format: TableFormat,
+			out:    "GREETING        KEY ID\nHello World :)  default\n",
+		},
+		{
+			desc:   "ping with json format",
+			secret: mfxkittest.DefSecret,
+			format: JSONFormat,
+			out:    "{\n  \"greeting\": \"Hello World :)\",\n  \"key_id\": \"default\"\n}\n",
+		},
+		{
+			desc:   "ping with invalid secret",
+			secret: "invalid",
+			format: TableFormat,
+			err:    mfxkitsdk.ErrUnauthorized,
+		},
+		{
+			desc:   "ping with invalid format",
+			secret: mfxkittest.DefSecret,
+			format: "xml",
+			err:    errInvalidFormat,
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := execute(ts.SDK, tc.secret, tc.format, "ping")
+		assert.True(t, errors.Is(err, tc.err), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+		assert.Equal(t, tc.out, res, fmt.Sprintf("%s: expected output %q got %q", tc.desc, tc.out, res))
+	}
+}
+
+func TestHealth(t *testing.T) {
+	ts := mfxkittest.NewServer(mfxkittest.Options{})
+	defer ts.Close()
+
+	res, err := execute(ts.SDK, "", JSONFormat, "health")
+	require.Nil(t, err, fmt.Sprintf("health of running service: unexpected error: %s", err))
+	var h healthRow
+	err = json.Unmarshal([]byte(res), &h)
+	require.Nil(t, err, fmt.Sprintf("health of running service: unexpected error: %s", err))
+	assert.Equal(t, "pass", h.Status, fmt.Sprintf("health of running service: expected status pass got %s", h.Status))
+	assert.NotEmpty(t, h.Version, "health of running service: expected the service version")
+
+	ts.Close()
+
+	res, err = execute(ts.SDK, "", JSONFormat, "health")
+	assert.NotNil(t, err, "health of stopped service: expected error")
+	h = healthRow{}
+	err = json.Unmarshal([]byte(res), &h)
+	require.Nil(t, err, fmt.Sprintf("health of stopped service: unexpected error: %s", err))
+	assert.Equal(t, "fail", h.Status, fmt.Sprintf("health of stopped service: expected status fail got %s", h.Status))
+	assert.NotEmpty(t, h.Error, "health of stopped service: expected the error to be reported")
+}
+
+func TestVersion(t *testing.T) {
+	ts := mfxkittest.NewServer(mfxkittest.Options{})
+	defer ts.Close()
+
+	v, err := ts.SDK.Version(context.Background())
+	require.Nil(t, err, fmt.Sprintf("service version: unexpected error: %s", err))
+
+	res, err := execute(ts.SDK, "", TableFormat, "version")
+	require.Nil(t, err, fmt.Sprintf("version: unexpected error: %s", err))
+	assert.Equal(t, v+"\n", res, fmt.Sprintf("version: expected %s got %s", v, res))
+
+	res, err = execute(ts.SDK, "", JSONFormat, "version")
+	require.Nil(t, err, fmt.Sprintf("version with json format: unexpected error: %s", err))
+	assert.JSONEq(t, fmt.Sprintf(`{"message":%q}`, v), res, fmt.Sprintf("version with json format: unexpected output %s", res))
+}
+
+func TestKits(t *testing.T) {
+	ts := mfxkittest.NewServer(mfxkittest.Options{Retention: time.Hour})
+	defer ts.Close()
+
+	kit := createKit(t, ts, `{"name":"first","metadata":{"type":"demo"}}`)
+	other := createKit(t, ts, `{"name":"second"}`)
+	assert.Equal(t, "first", kit.Name, fmt.Sprintf("create kit: expected name first got %s", kit.Name))
+	assert.Equal(t, uint64(1), kit.Revision, fmt.Sprintf("create kit: expected revision 1 got %d", kit.Revision))
+
+	cases := []struct {
+		desc     string
+		args     []string
+		contains []string
+		excludes []string
+		err      error
+	}{
+		{
+			desc:     "get kit by ID",
+			args:     []string{"kits", "get", kit.ID},
+			contains: []string{kit.ID, "first", `{"type":"demo"}`},
+		},
+		{
+			desc:     "get all kits",
+			args:     []string{"kits", "get", "all"},
+			contains: []string{kit.ID, other.ID, "showing 2 of 2 kits from offset 0"},
+		},
+		{
+			desc:     "get kits by metadata",
+			args:     []string{"kits", "get", "all", "--metadata", `{"type":"demo"}`},
+			contains: []string{kit.ID, "showing 1 of 1 kits"},
+			excludes: []string{other.ID},
+		},
+		{
+			desc:     "get kits by name with limit",
+			args:     []string{"kits", "get", "all", "--name", "second", "--limit", "1"},
+			contains: []string{other.ID, "showing 1 of 1 kits"},
+			excludes: []string{kit.ID},
+		},
+		{
+			desc: "get non-existent kit",
+			args: []string{"kits", "get", "unknown"},
+			err:  mfxkitsdk.ErrNotFound,
+		},
+		{
+			desc:     "update kit",
+			args:     []string{"kits", "update", kit.ID, `{"name":"renamed"}`, "--revision", "1"},
+			contains: []string{kit.ID, "renamed"},
+		},
+		{
+			desc: "update kit with stale revision",
+			args: []string{"kits", "update", kit.ID, `{"name":"stale"}`, "--revision", "1"},
+			err:  mfxkitsdk.ErrConflict,
+		},
+		{
+			desc:     "delete kit",
+			args:     []string{"kits", "delete", other.ID},
+			contains: []string{"ok"},
+		},
+		{
+			desc:     "restore kit",
+			args:     []string{"kits", "restore", other.ID},
+			contains: []string{other.ID, "second"},
+		},
+		{
+			desc:     "delete kits",
+			args:     []string{"kits", "delete", kit.ID, "unknown"},
+			contains: []string{kit.ID, "204", "unknown", "404", mfxkitsdk.ErrNotFound.Error()},
+		},
+	}
+
+	for _, tc := range cases {
+		res, err := execute(ts.SDK, mfxkittest.DefSecret, TableFormat, tc.args...)
+		assert.True(t, errors.Is(err, tc.err), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+		for _, s := range tc.contains {
+			assert.Contains(t, res, s, fmt.Sprintf("%s: expected output to contain %s", tc.desc, s))
+		}
+		for _, s := range tc.excludes {
+			assert.NotContains(t, res, s, fmt.Sprintf("%s: expected output not to contain %s", tc.desc, s))
+		}
+	}
+}
+
+func TestKitsInvalidArgs(t *testing.T) {
+	ts := mfxkittest.NewServer(mfxkittest.Options{})
+	defer ts.Close()
+
+	cases := []struct {
+		desc string
+		args []string
+	}{
+		{"create kit with invalid JSON", []string{"kits", "create", "{"}},
+		{"create kit without kit", []string{"kits", "create"}},
+		{"update kit with invalid JSON", []string{"kits", "update", "id", "{"}},
+		{"get kits with invalid metadata", []string{"kits", "get", "all", "--metadata", "{"}},
+		{"delete kits without ID", []string{"kits", "delete"}},
+	}
+
+	for _, tc := range cases {
+		res, err := execute(ts.SDK, mfxkittest.DefSecret, TableFormat, tc.args...)
+		assert.NotNil(t, err, fmt.Sprintf("%s: expected error", tc.desc))
+		assert.Empty(t, strings.TrimSpace(res), fmt.Sprintf("%s: expected no output got %s", tc.desc, res))
+	}
+}
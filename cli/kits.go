@@ -0,0 +1,211 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	mfxkitsdk "github.com/mainflux/mfxkit/pkg/sdk/go"
+	"github.com/spf13/cobra"
+)
+
+type kitRow mfxkitsdk.Kit
+
+func (k kitRow) header() []string {
+	return []string{"ID", "NAME", "REVISION", "CREATED", "UPDATED", "METADATA"}
+}
+
+func (k kitRow) values() []string {
+	metadata := ""
+	if len(k.Metadata) > 0 {
+		data, _ := json.Marshal(k.Metadata)
+		metadata = string(data)
+	}
+
+	return []string{
+		k.ID,
+		k.Name,
+		strconv.FormatUint(k.Revision, 10),
+		k.CreatedAt.Format(time.RFC3339),
+		k.UpdatedAt.Format(time.RFC3339),
+		metadata,
+	}
+}
+
+type bulkRow mfxkitsdk.BulkResult
+
+func (b bulkRow) header() []string {
+	return []string{"ID", "STATUS", "ERROR"}
+}
+
+func (b bulkRow) values() []string {
+	return []string{b.ID, strconv.Itoa(b.Status), b.Error}
+}
+
+var cmdKits = []cobra.Command{
+	{
+		Use:   "create <JSON_kit>",
+		Short: "Create kit",
+		Long:  `Create new kit, e.g. '{"name":"kit","metadata":{"type":"demo"}}'`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var kit mfxkitsdk.Kit
+			if err := json.Unmarshal([]byte(args[0]), &kit); err != nil {
+				return err
+			}
+
+			k, err := sdk.CreateKit(context.Background(), kit, Secret)
+			if err != nil {
+				return err
+			}
+
+			return printResult(kitRow(k))
+		},
+	},
+	{
+		Use:   "get [all | <kit_id>]",
+		Short: "Get kits",
+		Long:  `Get the page of kits filtered by the query flags, or a single kit by ID`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if args[0] != "all" {
+				k, err := sdk.Kit(context.Background(), args[0], Secret)
+				if err != nil {
+					return err
+				}
+
+				return printResult(kitRow(k))
+			}
+
+			pm := mfxkitsdk.PageMetadata{
+				Offset: Offset,
+				Limit:  Limit,
+				Order:  Order,
+				Dir:    Dir,
+				Name:   Name,
+			}
+			if Metadata != "" {
+				if err := json.Unmarshal([]byte(Metadata), &pm.Metadata); err != nil {
+					return err
+				}
+			}
+
+			page, err := sdk.Kits(context.Background(), pm, Secret)
+			if err != nil {
+				return err
+			}
+
+			if Format == JSONFormat {
+				return printResult(page)
+			}
+
+			rows := make([]row, len(page.Kits))
+			for i, k := range page.Kits {
+				rows[i] = kitRow(k)
+			}
+			if err := printResult(rows); err != nil {
+				return err
+			}
+
+			return printMessage(fmt.Sprintf("\nshowing %d of %d kits from offset %d", len(page.Kits), page.Total, page.Offset))
+		},
+	},
+	{
+		Use:   "update <kit_id> <JSON_kit>",
+		Short: "Update kit",
+		Long:  `Update name and metadata of the kit. If the revision flag is set, it must match the current kit revision`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var kit mfxkitsdk.Kit
+			if err := json.Unmarshal([]byte(args[1]), &kit); err != nil {
+				return err
+			}
+			kit.ID = args[0]
+			kit.Revision = Revision
+
+			k, err := sdk.UpdateKit(context.Background(), kit, Secret)
+			if err != nil {
+				return err
+			}
+
+			return printResult(kitRow(k))
+		},
+	},
+	{
+		Use:   "delete <kit_id> [<kit_id>...]",
+		Short: "Delete kits",
+		Long:  `Remove kits. If the revision flag is set, it must match the current revision of a single kit`,
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				if err := sdk.DeleteKit(context.Background(), args[0], Revision, Secret); err != nil {
+					return err
+				}
+
+				return printMessage("ok")
+			}
+
+			kits := make([]mfxkitsdk.Kit, len(args))
+			for i, id := range args {
+				kits[i] = mfxkitsdk.Kit{ID: id}
+			}
+
+			res, err := sdk.DeleteKits(context.Background(), kits, false, Secret)
+			if err != nil {
+				return err
+			}
+
+			if Format == JSONFormat {
+				return printResult(res)
+			}
+
+			rows := make([]row, len(res))
+			for i, r := range res {
+				rows[i] = bulkRow(r)
+			}
+			return printResult(rows)
+		},
+	},
+	{
+		Use:   "restore <kit_id>",
+		Short: "Restore kit",
+		Long:  `Restore the kit removed during the retention period`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			k, err := sdk.RestoreKit(context.Background(), args[0], Secret)
+			if err != nil {
+				return err
+			}
+
+			return printResult(kitRow(k))
+		},
+	},
+}
+
+// NewKitsCmd returns kits command.
+func NewKitsCmd() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "kits [create | get | update | delete | restore]",
+		Short: "Kits management",
+		Long:  `Kits management: create, get, update, delete or restore kits`,
+	}
+
+	for i := range cmdKits {
+		cmd.AddCommand(&cmdKits[i])
+	}
+
+	cmd.PersistentFlags().Uint64VarP(&Limit, "limit", "l", Limit, "limit query parameter")
+	cmd.PersistentFlags().Uint64VarP(&Offset, "offset", "o", Offset, "offset query parameter")
+	cmd.PersistentFlags().StringVarP(&Name, "name", "n", Name, "name query parameter")
+	cmd.PersistentFlags().StringVar(&Order, "order", Order, "order query parameter (id, name)")
+	cmd.PersistentFlags().StringVar(&Dir, "dir", Dir, "direction query parameter (asc, desc)")
+	cmd.PersistentFlags().StringVar(&Metadata, "metadata", Metadata, "JSON metadata query parameter")
+	cmd.PersistentFlags().Uint64VarP(&Revision, "revision", "r", Revision, "expected kit revision")
+
+	return &cmd
+}
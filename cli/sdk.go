@@ -0,0 +1,14 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import mfxkitsdk "github.com/mainflux/mfxkit/pkg/sdk/go"
+
+// Keep SDK handle in global var
+var sdk mfxkitsdk.SDK
+
+// SetSDK sets mfxkit SDK instance.
+func SetSDK(s mfxkitsdk.SDK) {
+	sdk = s
+}
@@ -0,0 +1,104 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+type pingRow struct {
+	Greeting string `json:"greeting"`
+	KeyID    string `json:"key_id"`
+}
+
+func (p pingRow) header() []string {
+	return []string{"GREETING", "KEY ID"}
+}
+
+func (p pingRow) values() []string {
+	return []string{p.Greeting, p.KeyID}
+}
+
+type healthRow struct {
+	Status  string `json:"status"`
+	Version string `json:"version,omitempty"`
+	Latency string `json:"latency"`
+	Error   string `json:"error,omitempty"`
+}
+
+func (h healthRow) header() []string {
+	return []string{"STATUS", "VERSION", "LATENCY", "ERROR"}
+}
+
+func (h healthRow) values() []string {
+	return []string{h.Status, h.Version, h.Latency, h.Error}
+}
+
+// NewPingCmd returns ping command.
+func NewPingCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "ping",
+		Short: "Ping mfxkit service",
+		Long:  `Ping mfxkit service using the configured secret`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			greeting, keyID, err := sdk.Ping(context.Background(), Secret)
+			if err != nil {
+				return err
+			}
+
+			return printResult(pingRow{Greeting: greeting, KeyID: keyID})
+		},
+	}
+}
+
+// NewVersionCmd returns version command.
+func NewVersionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Mfxkit service version",
+		Long:  `Mfxkit service version: get version of the running mfxkit service`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			v, err := sdk.Version(context.Background())
+			if err != nil {
+				return err
+			}
+
+			return printMessage(v)
+		},
+	}
+}
+
+// NewHealthCmd returns health command.
+func NewHealthCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "health",
+		Short: "Mfxkit service health",
+		Long:  `Mfxkit service health: check whether the service responds and report its response time`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			start := time.Now()
+			v, err := sdk.Version(context.Background())
+			h := healthRow{
+				Status:  "pass",
+				Version: v,
+				Latency: time.Since(start).Round(time.Microsecond).String(),
+			}
+			if err != nil {
+				h.Status = "fail"
+				h.Error = err.Error()
+			}
+
+			if err := printResult(h); err != nil {
+				return err
+			}
+
+			return err
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+const (
+	// TableFormat prints results as aligned tables.
+	TableFormat = "table"
+
+	// JSONFormat prints results as indented JSON.
+	JSONFormat = "json"
+)
+
+var (
+	// Secret used to authenticate requests
+	Secret string = ""
+	// Format of the command output
+	Format string = TableFormat
+	// Limit query parameter
+	Limit uint64 = 0
+	// Offset query parameter
+	Offset uint64 = 0
+	// Name query parameter
+	Name string = ""
+	// Order query parameter
+	Order string = ""
+	// Dir query parameter
+	Dir string = ""
+	// Metadata query parameter
+	Metadata string = ""
+	// Revision of the kit used for the concurrency control
+	Revision uint64 = 0
+	// Timeout of a single request
+	Timeout time.Duration = 0
+)
+
+var (
+	errInvalidFormat = errors.New("invalid output format")
+
+	out io.Writer = os.Stdout
+)
+
+// row is implemented by results that can be printed as table rows.
+type row interface {
+	header() []string
+	values() []string
+}
+
+// printResult prints results in the selected format. The table format is used
+// for the results implementing row, and JSON for all the others.
+func printResult(res interface{}) error {
+	switch Format {
+	case JSONFormat:
+		return printJSON(res)
+	case TableFormat:
+	default:
+		return errInvalidFormat
+	}
+
+	switch r := res.(type) {
+	case row:
+		return printTable(r.header(), r.values())
+	case []row:
+		if len(r) == 0 {
+			return nil
+		}
+		rows := make([][]string, len(r))
+		for i := range r {
+			rows[i] = r[i].values()
+		}
+		return printTable(r[0].header(), rows...)
+	default:
+		return printJSON(res)
+	}
+}
+
+func printJSON(res interface{}) error {
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(res)
+}
+
+func printTable(header []string, rows ...[]string) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, r := range rows {
+		fmt.Fprintln(w, strings.Join(r, "\t"))
+	}
+
+	return w.Flush()
+}
+
+func printMessage(msg string) error {
+	if Format == JSONFormat {
+		return printJSON(map[string]string{"message": msg})
+	}
+
+	_, err := fmt.Fprintln(out, msg)
+	return err
+}
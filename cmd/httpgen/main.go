@@ -0,0 +1,61 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Command httpgen generates the HTTP transport of the annotated methods of a
+// service interface. It is meant to be run using go:generate from the HTTP
+// transport package, e.g.
+//
+//	//go:generate go run github.com/mainflux/mfxkit/cmd/httpgen -src ../../..
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/mainflux/mfxkit/pkg/httpgen"
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+)
+
+func main() {
+	var (
+		src   = flag.String("src", ".", "Directory of the package declaring the service interface")
+		iface = flag.String("iface", "Service", "Name of the service interface")
+		out   = flag.String("out", "routes.go", "Output file")
+		pkg   = flag.String("pkg", os.Getenv("GOPACKAGE"), "Package name of the generated code (default is the package running go:generate)")
+		tags  = flag.String("tags", "", "Build constraint of the generated file")
+	)
+	flag.Parse()
+
+	if *pkg == "" {
+		abs, err := filepath.Abs(filepath.Dir(*out))
+		if err != nil {
+			exit(err)
+		}
+		*pkg = filepath.Base(abs)
+	}
+
+	svc, err := mwgen.Parse(*src, *iface)
+	if err != nil {
+		exit(err)
+	}
+
+	code, err := httpgen.Generate(svc, httpgen.Config{
+		Package:   *pkg,
+		BuildTags: *tags,
+	})
+	if err != nil {
+		exit(err)
+	}
+
+	if err := ioutil.WriteFile(*out, code, 0644); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintf(os.Stderr, "httpgen: %s\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/mainflux/mainflux"
+	"github.com/mainflux/mfxkit/cli"
+	mfxkitsdk "github.com/mainflux/mfxkit/pkg/sdk/go"
+	"github.com/spf13/cobra"
+)
+
+const (
+	defURL      = "http://localhost:9021"
+	defSecret   = ""
+	defInsecure = "false"
+	defCACert   = ""
+	defChannel  = ""
+	defTimeout  = "10s"
+	defFormat   = cli.TableFormat
+
+	envURL      = "MF_MFXKIT_URL"
+	envSecret   = "MF_MFXKIT_SECRET"
+	envInsecure = "MF_MFXKIT_TLS_INSECURE"
+	envCACert   = "MF_MFXKIT_CA_CERT"
+	envChannel  = "MF_MFXKIT_CHANNEL_ID"
+	envTimeout  = "MF_MFXKIT_TIMEOUT"
+	envFormat   = "MF_MFXKIT_FORMAT"
+)
+
+var (
+	errInvalidFormat = errors.New("invalid output format, expected table or json")
+	errInvalidCACert = errors.New("failed to append CA certificate")
+)
+
+func main() {
+	insecure, err := strconv.ParseBool(mainflux.Env(envInsecure, defInsecure))
+	if err != nil {
+		exit(fmt.Errorf("invalid value of %s: %w", envInsecure, err))
+	}
+
+	timeout, err := time.ParseDuration(mainflux.Env(envTimeout, defTimeout))
+	if err != nil {
+		exit(fmt.Errorf("invalid value of %s: %w", envTimeout, err))
+	}
+
+	var (
+		url     = mainflux.Env(envURL, defURL)
+		caCert  = mainflux.Env(envCACert, defCACert)
+		channel = mainflux.Env(envChannel, defChannel)
+	)
+	cli.Secret = mainflux.Env(envSecret, defSecret)
+	cli.Format = mainflux.Env(envFormat, defFormat)
+	cli.Timeout = timeout
+
+	rootCmd := &cobra.Command{
+		Use:           "mfxkit-cli",
+		Short:         "Mfxkit command-line client",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if cli.Format != cli.TableFormat && cli.Format != cli.JSONFormat {
+				return errInvalidFormat
+			}
+
+			conf := mfxkitsdk.Config{
+				BaseURL:     url,
+				Timeout:     cli.Timeout,
+				TLSInsecure: insecure,
+				ChannelID:   channel,
+			}
+
+			if caCert != "" {
+				tlsConfig, err := loadTLSConfig(caCert, insecure)
+				if err != nil {
+					return err
+				}
+				conf.TLSConfig = tlsConfig
+			}
+
+			cli.SetSDK(mfxkitsdk.NewSDK(conf))
+			return nil
+		},
+	}
+
+	rootCmd.AddCommand(cli.NewPingCmd())
+	rootCmd.AddCommand(cli.NewHealthCmd())
+	rootCmd.AddCommand(cli.NewVersionCmd())
+	rootCmd.AddCommand(cli.NewKitsCmd())
+
+	rootCmd.PersistentFlags().StringVarP(&url, "url", "u", url, "Mfxkit service URL")
+	rootCmd.PersistentFlags().StringVarP(&cli.Secret, "secret", "s", cli.Secret, "Mfxkit service secret")
+	rootCmd.PersistentFlags().StringVarP(&channel, "channel", "c", channel, "Channel ID, to authenticate using the thing key as the secret")
+	rootCmd.PersistentFlags().BoolVarP(&insecure, "insecure", "i", insecure, "Skip server certificate verification")
+	rootCmd.PersistentFlags().StringVar(&caCert, "ca-cert", caCert, "Path to the CA certificate in pem format")
+	rootCmd.PersistentFlags().DurationVarP(&cli.Timeout, "timeout", "t", cli.Timeout, "Request timeout, 0 disables it")
+	rootCmd.PersistentFlags().StringVarP(&cli.Format, "format", "f", cli.Format, "Output format (table, json)")
+
+	if err := rootCmd.Execute(); err != nil {
+		exit(err)
+	}
+}
+
+func loadTLSConfig(caCert string, insecure bool) (*tls.Config, error) {
+	pem, err := ioutil.ReadFile(caCert)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, errInvalidCACert
+	}
+
+	return &tls.Config{
+		RootCAs:            pool,
+		InsecureSkipVerify: insecure,
+	}, nil
+}
+
+func exit(err error) {
+	fmt.Fprintf(os.Stderr, "error: %s\n", err)
+	os.Exit(1)
+}
@@ -8,89 +8,527 @@
 package main
 
 import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"database/sql"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"runtime"
+	"strconv"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/mainflux/mainflux"
 	"github.com/mainflux/mainflux/logger"
+	"github.com/mainflux/mainflux/pkg/uuid"
 	"github.com/mainflux/mfxkit/mfxkit"
 	"github.com/mainflux/mfxkit/mfxkit/api"
+	mfxkitgrpcapi "github.com/mainflux/mfxkit/mfxkit/api/mfxkit/grpc"
 	mfxkithttpapi "github.com/mainflux/mfxkit/mfxkit/api/mfxkit/http"
+	"github.com/mainflux/mfxkit/mfxkit/auth"
+	"github.com/mainflux/mfxkit/mfxkit/bolt"
+	"github.com/mainflux/mfxkit/mfxkit/hasher"
+	"github.com/mainflux/mfxkit/mfxkit/memory"
+	"github.com/mainflux/mfxkit/mfxkit/secrets"
+	"github.com/mainflux/mfxkit/mfxkit/sqldb"
 
-	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
 	opentracing "github.com/opentracing/opentracing-go"
 	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cobra"
 	jconfig "github.com/uber/jaeger-client-go/config"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 const (
-	defLogLevel   = "error"
-	defHTTPPort   = "9021"
-	defJaegerURL  = ""
-	defServerCert = ""
-	defServerKey  = ""
-	defSecret     = "secret"
-
-	envLogLevel   = "MF_MFXKIT_LOG_LEVEL"
-	envHTTPPort   = "MF_MFXKIT_HTTP_PORT"
-	envServerCert = "MF_MFXKIT_SERVER_CERT"
-	envServerKey  = "MF_MFXKIT_SERVER_KEY"
-	envSecret     = "MF_MFXKIT_SECRET"
-	envJaegerURL  = "MF_JAEGER_URL"
+	defLogLevel      = "error"
+	defHTTPPort      = "9021"
+	defGRPCPort      = "9020"
+	defJaegerURL     = ""
+	defServerCert    = ""
+	defServerKey     = ""
+	defSecret        = "secret"
+	defSecrets       = ""
+	defSecretFile    = ""
+	defSecretsFile   = ""
+	defSecretsDir    = ""
+	defSecretsPrefix = "mfxkit_secret"
+	defReloadEvery   = "30s"
+	defDevMode       = "false"
+	defAuthURL       = ""
+	defAuthTimeout   = "1s"
+	defThingsURL     = ""
+	defThingsTimeout = "1s"
+	defClientTLS     = "false"
+	defCACerts       = ""
+	defRetention     = "720h"
+	defPurgeEvery    = "1h"
+	defDBType        = "memory"
+	defDBHost        = "localhost"
+	defDBPort        = "5432"
+	defDBUser        = "mainflux"
+	defDBPass        = "mainflux"
+	defDBName        = "mfxkit"
+	defDBSSLMode     = "disable"
+	defDBSSLCert     = ""
+	defDBSSLKey      = ""
+	defDBSSLRoot     = ""
+	defDBMigrate     = "true"
+
+	envLogLevel      = "MF_MFXKIT_LOG_LEVEL"
+	envHTTPPort      = "MF_MFXKIT_HTTP_PORT"
+	envGRPCPort      = "MF_MFXKIT_GRPC_PORT"
+	envServerCert    = "MF_MFXKIT_SERVER_CERT"
+	envServerKey     = "MF_MFXKIT_SERVER_KEY"
+	envSecret        = "MF_MFXKIT_SECRET"
+	envSecrets       = "MF_MFXKIT_SECRETS"
+	envSecretFile    = "MF_MFXKIT_SECRET_FILE"
+	envSecretsFile   = "MF_MFXKIT_SECRETS_FILE"
+	envSecretsDir    = "MF_MFXKIT_SECRETS_DIR"
+	envSecretsPrefix = "MF_MFXKIT_SECRETS_PREFIX"
+	envReloadEvery   = "MF_MFXKIT_SECRETS_RELOAD_INTERVAL"
+	envDevMode       = "MF_MFXKIT_DEV_MODE"
+	envAuthURL       = "MF_AUTH_GRPC_URL"
+	envAuthTimeout   = "MF_AUTH_GRPC_TIMEOUT"
+	envThingsURL     = "MF_THINGS_AUTH_GRPC_URL"
+	envThingsTimeout = "MF_THINGS_AUTH_GRPC_TIMEOUT"
+	envClientTLS     = "MF_MFXKIT_CLIENT_TLS"
+	envCACerts       = "MF_MFXKIT_CA_CERTS"
+	envJaegerURL     = "MF_JAEGER_URL"
+	envRetention     = "MF_MFXKIT_RETENTION"
+	envPurgeEvery    = "MF_MFXKIT_PURGE_INTERVAL"
+	envDBType        = "MF_MFXKIT_DB_TYPE"
+	envDBHost        = "MF_MFXKIT_DB_HOST"
+	envDBPort        = "MF_MFXKIT_DB_PORT"
+	envDBUser        = "MF_MFXKIT_DB_USER"
+	envDBPass        = "MF_MFXKIT_DB_PASS"
+	envDBName        = "MF_MFXKIT_DB"
+	envDBSSLMode     = "MF_MFXKIT_DB_SSL_MODE"
+	envDBSSLCert     = "MF_MFXKIT_DB_SSL_CERT"
+	envDBSSLKey      = "MF_MFXKIT_DB_SSL_KEY"
+	envDBSSLRoot     = "MF_MFXKIT_DB_SSL_ROOT_CERT"
+	envDBMigrate     = "MF_MFXKIT_DB_MIGRATE"
+
+	memoryDB = "memory"
+	boltDB   = "bolt"
+
+	defHealthTimeout = 5 * time.Second
+	redacted         = "[REDACTED]"
+	minSecretLen     = 16
+	purgerKeyID      = "purger"
+	purgerSecretLen  = 32
 )
 
+var errEmptySecret = errors.New("empty secret")
+
 type config struct {
-	logLevel     string
-	httpPort     string
-	authHTTPPort string
-	authGRPCPort string
-	serverCert   string
-	serverKey    string
-	secret       string
-	jaegerURL    string
+	logLevel      string
+	httpPort      string
+	authGRPCPort  string
+	serverCert    string
+	serverKey     string
+	secret        string
+	secrets       string
+	secretFile    string
+	secretsFile   string
+	secretsDir    string
+	secretsPrefix string
+	reloadEvery   time.Duration
+	devMode       bool
+	authURL       string
+	authTimeout   time.Duration
+	thingsURL     string
+	thingsTimeout time.Duration
+	clientTLS     bool
+	caCerts       string
+	jaegerURL     string
+	retention     time.Duration
+	purgeEvery    time.Duration
+	dbConfig      sqldb.Config
+	dbMigrate     bool
+}
+
+// Build information, set at link time using -ldflags "-X main.version=...".
+var (
+	version   = "dev"
+	commit    = "unknown"
+	buildTime = "unknown"
+)
+
+var rootCmd = &cobra.Command{
+	Use:   "mfxkit",
+	Short: "Mfxkit service",
+	Long:  `Mfxkit service, configured using MF_MFXKIT_* environment variables. Starts the service if no command is given.`,
+	Args:  cobra.NoArgs,
+	Run:   func(cmd *cobra.Command, args []string) { serve() },
 }
 
 func main() {
-	cfg := loadConfig()
+	rootCmd.AddCommand(newServeCmd())
+	rootCmd.AddCommand(newMigrateCmd())
+	rootCmd.AddCommand(newHealthcheckCmd())
+	rootCmd.AddCommand(newConfigCmd())
+	rootCmd.AddCommand(newHashCmd())
+	rootCmd.AddCommand(newVersionCmd())
 
-	logger, err := logger.New(os.Stdout, cfg.logLevel)
-	if err != nil {
-		log.Fatalf(err.Error())
+	if err := rootCmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func newServeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Start mfxkit service",
+		Long:  `Start mfxkit HTTP and gRPC servers and the removed kits purger`,
+		Args:  cobra.NoArgs,
+		Run:   func(cmd *cobra.Command, args []string) { serve() },
+	}
+}
+
+func newMigrateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate",
+		Short: "Apply database migrations",
+		Long:  `Apply pending database migrations and exit, so that schema can be upgraded before the service is started`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := loadConfig()
+			migrate(cfg.dbConfig, newLogger(cfg))
+		},
+	}
+}
+
+func newHealthcheckCmd() *cobra.Command {
+	var (
+		url     string
+		timeout time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "healthcheck",
+		Short: "Check mfxkit service health",
+		Long:  `Check whether the mfxkit service responds on its HTTP port, exiting with non-zero status on failure`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := loadConfig()
+			if url == "" {
+				url = localURL(cfg)
+			}
+
+			if err := healthcheck(url, timeout); err != nil {
+				fmt.Fprintf(os.Stderr, "Mfxkit service at %s is unhealthy: %s\n", url, err)
+				os.Exit(1)
+			}
+		},
 	}
 
+	cmd.Flags().StringVarP(&url, "url", "u", "", "Mfxkit service URL (default is the local instance)")
+	cmd.Flags().DurationVarP(&timeout, "timeout", "t", defHealthTimeout, "Health check timeout")
+
+	return cmd
+}
+
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config [print]",
+		Short: "Mfxkit service configuration",
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "print",
+		Short: "Print effective configuration",
+		Long:  `Print the effective configuration as environment variables, with secrets redacted`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			for _, v := range configVars(loadConfig()) {
+				val := v.value
+				if v.secret && val != "" {
+					val = redacted
+				}
+				fmt.Printf("%s=%s\n", v.name, val)
+			}
+		},
+	})
+
+	return cmd
+}
+
+func newHashCmd() *cobra.Command {
+	var alg string
+
+	cmd := &cobra.Command{
+		Use:   "hash [secret]",
+		Short: "Hash the service secret",
+		Long:  `Print the hash of the secret to be used as ` + envSecret + ` or in ` + envSecrets + `. The secret is read from the standard input if not given, to keep it out of the shell history`,
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			h, err := hasher.New(alg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to create %s hasher: %s\n", alg, err)
+				os.Exit(1)
+			}
+
+			var secret string
+			if len(args) > 0 {
+				secret = args[0]
+			} else {
+				secret, err = readSecret(os.Stdin)
+				if err != nil {
+					fmt.Fprintf(os.Stderr, "Failed to read secret: %s\n", err)
+					os.Exit(1)
+				}
+			}
+
+			if weakSecret(secret) {
+				fmt.Fprintf(os.Stderr, "Secret is weak, use at least %d characters\n", minSecretLen)
+			}
+
+			hash, err := h.Hash(secret)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to hash secret: %s\n", err)
+				os.Exit(1)
+			}
+			fmt.Println(hash)
+		},
+	}
+
+	cmd.Flags().StringVarP(&alg, "algorithm", "a", hasher.Argon2id, "Hashing algorithm (argon2id, bcrypt)")
+
+	return cmd
+}
+
+func newVersionCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Print build information",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Printf("version:    %s\n", version)
+			fmt.Printf("commit:     %s\n", commit)
+			fmt.Printf("built:      %s\n", buildTime)
+			fmt.Printf("go version: %s\n", runtime.Version())
+			fmt.Printf("platform:   %s/%s\n", runtime.GOOS, runtime.GOARCH)
+		},
+	}
+}
+
+func serve() {
+	cfg := loadConfig()
+	logger := newLogger(cfg)
+
 	mfxkitTracer, mfxkitCloser := initJaeger("mfxkit", cfg.jaegerURL, logger)
 	defer mfxkitCloser.Close()
 
-	svc := newService(cfg.secret, logger)
-	errs := make(chan error, 2)
+	kits, dbCloser := newKitRepository(cfg, logger)
+	defer dbCloser.Close()
+
+	h := hasher.NewArgon2id()
+	provider := newSecretProvider(cfg, h, logger)
+
+	authn, authCloser := newAuthenticator(cfg, logger)
+	defer authCloser.Close()
 
-	go startHTTPServer(mfxkithttpapi.MakeHandler(mfxkitTracer, svc), cfg.httpPort, cfg, logger, errs)
+	purgerSecret, svcSecrets := newPurgerSecret(provider, h, logger)
+
+	svc := newService(kits, svcSecrets, h, authn, cfg.retention, mfxkitTracer, logger)
+	errs := make(chan error, 3)
+
+	go provider.Watch(context.Background(), cfg.reloadEvery, logger)
+	go startPurger(svc, purgerSecret, cfg.purgeEvery, logger)
+	go startHTTPServer(mfxkithttpapi.MakeHandler(mfxkitTracer, svc, stdprometheus.DefaultGatherer), cfg.httpPort, cfg, logger, errs)
+	go startGRPCServer(svc, mfxkitTracer, cfg, logger, errs)
 
 	go func() {
-		c := make(chan os.Signal)
-		signal.Notify(c, syscall.SIGINT)
+		c := make(chan os.Signal, 1)
+		signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
 		errs <- fmt.Errorf("%s", <-c)
 	}()
 
-	err = <-errs
+	err := <-errs
 	logger.Error(fmt.Sprintf("Mfxkit service terminated: %s", err))
 }
 
 func loadConfig() config {
+	retention, err := time.ParseDuration(mainflux.Env(envRetention, defRetention))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envRetention, err)
+	}
+
+	purgeEvery, err := time.ParseDuration(mainflux.Env(envPurgeEvery, defPurgeEvery))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envPurgeEvery, err)
+	}
+
+	dbMigrate, err := strconv.ParseBool(mainflux.Env(envDBMigrate, defDBMigrate))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envDBMigrate, err)
+	}
+
+	devMode, err := strconv.ParseBool(mainflux.Env(envDevMode, defDevMode))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envDevMode, err)
+	}
+
+	authTimeout, err := time.ParseDuration(mainflux.Env(envAuthTimeout, defAuthTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envAuthTimeout, err)
+	}
+
+	thingsTimeout, err := time.ParseDuration(mainflux.Env(envThingsTimeout, defThingsTimeout))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envThingsTimeout, err)
+	}
+
+	clientTLS, err := strconv.ParseBool(mainflux.Env(envClientTLS, defClientTLS))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envClientTLS, err)
+	}
+
+	reloadEvery, err := time.ParseDuration(mainflux.Env(envReloadEvery, defReloadEvery))
+	if err != nil {
+		log.Fatalf("Invalid %s value: %s", envReloadEvery, err)
+	}
+
+	dbConfig := sqldb.Config{
+		Type:        mainflux.Env(envDBType, defDBType),
+		Host:        mainflux.Env(envDBHost, defDBHost),
+		Port:        mainflux.Env(envDBPort, defDBPort),
+		User:        mainflux.Env(envDBUser, defDBUser),
+		Pass:        mainflux.Env(envDBPass, defDBPass),
+		Name:        mainflux.Env(envDBName, defDBName),
+		SSLMode:     mainflux.Env(envDBSSLMode, defDBSSLMode),
+		SSLCert:     mainflux.Env(envDBSSLCert, defDBSSLCert),
+		SSLKey:      mainflux.Env(envDBSSLKey, defDBSSLKey),
+		SSLRootCert: mainflux.Env(envDBSSLRoot, defDBSSLRoot),
+	}
+
 	return config{
-		logLevel:   mainflux.Env(envLogLevel, defLogLevel),
-		httpPort:   mainflux.Env(envHTTPPort, defHTTPPort),
-		serverCert: mainflux.Env(envServerCert, defServerCert),
-		serverKey:  mainflux.Env(envServerKey, defServerKey),
-		jaegerURL:  mainflux.Env(envJaegerURL, defJaegerURL),
-		secret:     mainflux.Env(envSecret, defSecret),
+		logLevel:      mainflux.Env(envLogLevel, defLogLevel),
+		httpPort:      mainflux.Env(envHTTPPort, defHTTPPort),
+		authGRPCPort:  mainflux.Env(envGRPCPort, defGRPCPort),
+		serverCert:    mainflux.Env(envServerCert, defServerCert),
+		serverKey:     mainflux.Env(envServerKey, defServerKey),
+		jaegerURL:     mainflux.Env(envJaegerURL, defJaegerURL),
+		secret:        mainflux.Env(envSecret, defSecret),
+		secrets:       mainflux.Env(envSecrets, defSecrets),
+		secretFile:    mainflux.Env(envSecretFile, defSecretFile),
+		secretsFile:   mainflux.Env(envSecretsFile, defSecretsFile),
+		secretsDir:    mainflux.Env(envSecretsDir, defSecretsDir),
+		secretsPrefix: mainflux.Env(envSecretsPrefix, defSecretsPrefix),
+		reloadEvery:   reloadEvery,
+		devMode:       devMode,
+		authURL:       mainflux.Env(envAuthURL, defAuthURL),
+		authTimeout:   authTimeout,
+		thingsURL:     mainflux.Env(envThingsURL, defThingsURL),
+		thingsTimeout: thingsTimeout,
+		clientTLS:     clientTLS,
+		caCerts:       mainflux.Env(envCACerts, defCACerts),
+		retention:     retention,
+		purgeEvery:    purgeEvery,
+		dbConfig:      dbConfig,
+		dbMigrate:     dbMigrate,
+	}
+}
+
+func newLogger(cfg config) logger.Logger {
+	logger, err := logger.New(os.Stdout, cfg.logLevel)
+	if err != nil {
+		log.Fatalf(err.Error())
+	}
+
+	return logger
+}
+
+type configVar struct {
+	name   string
+	value  string
+	secret bool
+}
+
+// configVars returns the effective configuration as the environment
+// variables it was loaded from.
+func configVars(cfg config) []configVar {
+	return []configVar{
+		{name: envLogLevel, value: cfg.logLevel},
+		{name: envHTTPPort, value: cfg.httpPort},
+		{name: envGRPCPort, value: cfg.authGRPCPort},
+		{name: envServerCert, value: cfg.serverCert},
+		{name: envServerKey, value: cfg.serverKey},
+		{name: envJaegerURL, value: cfg.jaegerURL},
+		{name: envSecret, value: cfg.secret, secret: true},
+		{name: envSecrets, value: cfg.secrets, secret: true},
+		{name: envSecretFile, value: cfg.secretFile},
+		{name: envSecretsFile, value: cfg.secretsFile},
+		{name: envSecretsDir, value: cfg.secretsDir},
+		{name: envSecretsPrefix, value: cfg.secretsPrefix},
+		{name: envReloadEvery, value: cfg.reloadEvery.String()},
+		{name: envDevMode, value: strconv.FormatBool(cfg.devMode)},
+		{name: envAuthURL, value: cfg.authURL},
+		{name: envAuthTimeout, value: cfg.authTimeout.String()},
+		{name: envThingsURL, value: cfg.thingsURL},
+		{name: envThingsTimeout, value: cfg.thingsTimeout.String()},
+		{name: envClientTLS, value: strconv.FormatBool(cfg.clientTLS)},
+		{name: envCACerts, value: cfg.caCerts},
+		{name: envRetention, value: cfg.retention.String()},
+		{name: envPurgeEvery, value: cfg.purgeEvery.String()},
+		{name: envDBType, value: cfg.dbConfig.Type},
+		{name: envDBHost, value: cfg.dbConfig.Host},
+		{name: envDBPort, value: cfg.dbConfig.Port},
+		{name: envDBUser, value: cfg.dbConfig.User},
+		{name: envDBPass, value: cfg.dbConfig.Pass, secret: true},
+		{name: envDBName, value: cfg.dbConfig.Name},
+		{name: envDBSSLMode, value: cfg.dbConfig.SSLMode},
+		{name: envDBSSLCert, value: cfg.dbConfig.SSLCert},
+		{name: envDBSSLKey, value: cfg.dbConfig.SSLKey},
+		{name: envDBSSLRoot, value: cfg.dbConfig.SSLRootCert},
+		{name: envDBMigrate, value: strconv.FormatBool(cfg.dbMigrate)},
+	}
+}
+
+// localURL returns the URL of the HTTP API of the service running
+// on this host.
+func localURL(cfg config) string {
+	if cfg.serverCert != "" || cfg.serverKey != "" {
+		return fmt.Sprintf("https://localhost:%s", cfg.httpPort)
+	}
+
+	return fmt.Sprintf("http://localhost:%s", cfg.httpPort)
+}
+
+// healthcheck probes the version endpoint of the service. The server
+// certificate is not verified, since the probe usually targets localhost
+// which the certificate is not issued for.
+func healthcheck(url string, timeout time.Duration) error {
+	client := &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		},
+	}
+
+	resp, err := client.Get(fmt.Sprintf("%s/version", url))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s", resp.Status)
 	}
+
+	return nil
 }
 
 func initJaeger(svcName, url string, logger logger.Logger) (opentracing.Tracer, io.Closer) {
@@ -117,29 +555,297 @@ func initJaeger(svcName, url string, logger logger.Logger) (opentracing.Tracer,
 	return tracer, closer
 }
 
-func newService(secret string, logger logger.Logger) mfxkit.Service {
-	svc := mfxkit.New(secret)
+func newKitRepository(cfg config, logger logger.Logger) (mfxkit.KitRepository, io.Closer) {
+	switch cfg.dbConfig.Type {
+	case memoryDB:
+		return memory.NewKitRepository(), ioutil.NopCloser(nil)
+	case boltDB:
+		db, err := bolt.Connect(cfg.dbConfig.Name)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed to open bolt database: %s", err))
+			os.Exit(1)
+		}
+		return bolt.NewKitRepository(db), db
+	}
+
+	db := connectToDB(cfg.dbConfig, logger)
+	if cfg.dbMigrate {
+		applyMigrations(db, logger)
+	}
+
+	return sqldb.NewKitRepository(db), db
+}
+
+// newAuthenticator returns the authenticator identifying the callers using
+// the Mainflux auth service, the things service, or both, if their URLs are
+// configured. Otherwise, the returned authenticator is nil, so that the
+// service secrets are used.
+func newAuthenticator(cfg config, logger logger.Logger) (mfxkit.Authenticator, io.Closer) {
+	var authn mfxkit.Authenticator
+	var conns closers
+
+	if cfg.authURL != "" {
+		conn := connectToGRPC(cfg, cfg.authURL, "auth", logger)
+		conns = append(conns, conn)
+		authn = auth.NewUsers(mainflux.NewAuthServiceClient(conn), cfg.authTimeout)
+		logger.Info(fmt.Sprintf("Users are identified by the auth service at %s", cfg.authURL))
+	}
+
+	if cfg.thingsURL != "" {
+		conn := connectToGRPC(cfg, cfg.thingsURL, "things", logger)
+		conns = append(conns, conn)
+		authn = auth.NewThings(mainflux.NewThingsServiceClient(conn), cfg.thingsTimeout, authn)
+		logger.Info(fmt.Sprintf("Things are identified by the things service at %s", cfg.thingsURL))
+	}
+
+	return authn, conns
+}
+
+// closers closes all of its closers.
+type closers []io.Closer
+
+func (cs closers) Close() error {
+	for _, c := range cs {
+		c.Close()
+	}
+
+	return nil
+}
+
+func connectToGRPC(cfg config, url, svcName string, logger logger.Logger) *grpc.ClientConn {
+	var opts []grpc.DialOption
+	if cfg.clientTLS {
+		creds := credentials.NewTLS(&tls.Config{})
+		if cfg.caCerts != "" {
+			var err error
+			if creds, err = credentials.NewClientTLSFromFile(cfg.caCerts, ""); err != nil {
+				logger.Error(fmt.Sprintf("Failed to load CA certificates: %s", err))
+				os.Exit(1)
+			}
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+		logger.Info(fmt.Sprintf("gRPC communication with the %s service is not encrypted", svcName))
+	}
+
+	conn, err := grpc.Dial(url, opts...)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to %s service: %s", svcName, err))
+		os.Exit(1)
+	}
+
+	return conn
+}
+
+func connectToDB(dbConfig sqldb.Config, logger logger.Logger) *sql.DB {
+	db, err := sqldb.Connect(dbConfig)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to connect to %s database: %s", dbConfig.Type, err))
+		os.Exit(1)
+	}
+
+	return db
+}
+
+func applyMigrations(db *sql.DB, logger logger.Logger) {
+	n, err := sqldb.Migrate(db)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to apply database migrations: %s", err))
+		os.Exit(1)
+	}
+	logger.Info(fmt.Sprintf("Applied %d database migrations", n))
+}
+
+// migrate applies database migrations and exits, so that schema can be
+// upgraded before the service is started.
+func migrate(dbConfig sqldb.Config, logger logger.Logger) {
+	db := connectToDB(dbConfig, logger)
+	defer db.Close()
+
+	applyMigrations(db, logger)
+}
+
+// newSecretProvider returns the provider of the service secrets read from
+// the secrets directory, the secrets file, the secret file, or environment
+// variables, in that order of precedence.
+func newSecretProvider(cfg config, h mfxkit.Hasher, logger logger.Logger) *secrets.Provider {
+	prepare := prepareSecrets(cfg, h, logger)
+
+	var p *secrets.Provider
+	var err error
+	switch {
+	case cfg.secretsDir != "":
+		p, err = secrets.NewDir(cfg.secretsDir, cfg.secretsPrefix, prepare)
+	case cfg.secretsFile != "":
+		p, err = secrets.NewFile(cfg.secretsFile, true, prepare)
+	case cfg.secretFile != "":
+		p, err = secrets.NewFile(cfg.secretFile, false, prepare)
+	default:
+		p, err = secrets.NewEnv(cfg.secret, cfg.secrets, prepare)
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to load service secrets: %s", err))
+		os.Exit(1)
+	}
+
+	logger.Info(fmt.Sprintf("Service secrets loaded from %s", p.Source()))
+
+	return p
+}
+
+// prepareSecrets returns the function that hashes plain secrets using the
+// given hasher. Weak and default secrets are rejected unless the service
+// runs in the development mode.
+func prepareSecrets(cfg config, h mfxkit.Hasher, logger logger.Logger) secrets.PrepareFunc {
+	return func(entries []secrets.Entry) ([]mfxkit.Secret, error) {
+		now := time.Now()
+		ss := make([]mfxkit.Secret, len(entries))
+		valid := false
+		for i, e := range entries {
+			hash, err := hashSecret(cfg, e, h, logger)
+			if err != nil {
+				return nil, err
+			}
+			ss[i] = mfxkit.Secret{
+				ID:        e.ID,
+				Hash:      hash,
+				NotBefore: e.NotBefore,
+				NotAfter:  e.NotAfter,
+			}
+			valid = valid || ss[i].Valid(now)
+		}
+
+		if !valid {
+			logger.Warn("None of the service secrets is currently valid")
+		}
+
+		return ss, nil
+	}
+}
+
+// hashSecret returns the hash of the service secret, hashing plain secret
+// using the given hasher.
+func hashSecret(cfg config, e secrets.Entry, h mfxkit.Hasher, logger logger.Logger) (string, error) {
+	if hasher.IsHash(e.Secret) {
+		if h.Compare(defSecret, e.Secret) == nil {
+			if err := checkDevMode(cfg, fmt.Sprintf("Default service secret %s", e.ID), logger); err != nil {
+				return "", err
+			}
+		}
+		return e.Secret, nil
+	}
+
+	if weakSecret(e.Secret) {
+		if err := checkDevMode(cfg, fmt.Sprintf("Weak service secret %s", e.ID), logger); err != nil {
+			return "", err
+		}
+	}
+	logger.Warn(fmt.Sprintf("Service secret %s is not hashed, use the hash command to hash it", e.ID))
+
+	hash, err := h.Hash(e.Secret)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash service secret %s: %w", e.ID, err)
+	}
+
+	return hash, nil
+}
+
+// checkDevMode rejects the problem unless the service runs in the
+// development mode, in which case the problem is only reported.
+func checkDevMode(cfg config, problem string, logger logger.Logger) error {
+	if !cfg.devMode {
+		return fmt.Errorf("%s rejected, use a strong secret or set %s=true for development", problem, envDevMode)
+	}
+	logger.Warn(fmt.Sprintf("%s accepted in development mode", problem))
 
+	return nil
+}
+
+func weakSecret(secret string) bool {
+	return len(secret) < minSecretLen || secret == defSecret
+}
+
+// readSecret reads the first line of the reader.
+func readSecret(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	secret := strings.TrimRight(line, "\r\n")
+	if secret == "" {
+		return "", errEmptySecret
+	}
+
+	return secret, nil
+}
+
+func newService(kits mfxkit.KitRepository, provider mfxkit.SecretProvider, h mfxkit.Hasher, authn mfxkit.Authenticator, retention time.Duration, tracer opentracing.Tracer, logger logger.Logger) mfxkit.Service {
+	idProvider := uuid.New()
+
+	svc := mfxkit.New(provider, h, authn, kits, idProvider, retention)
+
+	svc = api.TracingMiddleware(svc, tracer)
 	svc = api.LoggingMiddleware(svc, logger)
-	svc = api.MetricsMiddleware(
-		svc,
-		kitprometheus.NewCounterFrom(stdprometheus.CounterOpts{
-			Namespace: "mfxkit",
-			Subsystem: "api",
-			Name:      "request_count",
-			Help:      "Number of requests received.",
-		}, []string{"method"}),
-		kitprometheus.NewSummaryFrom(stdprometheus.SummaryOpts{
-			Namespace: "mfxkit",
-			Subsystem: "api",
-			Name:      "request_latency_microseconds",
-			Help:      "Total duration of requests in microseconds.",
-		}, []string{"method"}),
-	)
+	counter, latency, items := api.MakeMetrics(stdprometheus.DefaultRegisterer)
+	svc = api.MetricsMiddleware(svc, counter, latency, items)
 
 	return svc
 }
 
+// newPurgerSecret returns the secret the kits purger authenticates with, and
+// the provider of the service secrets extended with its hash. The secret is
+// generated on every start and never leaves the process, so that purging
+// stays restricted to the holders of the service secrets.
+func newPurgerSecret(provider mfxkit.SecretProvider, h mfxkit.Hasher, logger logger.Logger) (string, mfxkit.SecretProvider) {
+	b := make([]byte, purgerSecretLen)
+	if _, err := rand.Read(b); err != nil {
+		logger.Error(fmt.Sprintf("Failed to generate purger secret: %s", err))
+		os.Exit(1)
+	}
+	secret := hex.EncodeToString(b)
+
+	hash, err := h.Hash(secret)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to hash purger secret: %s", err))
+		os.Exit(1)
+	}
+
+	return secret, purgerSecrets{
+		SecretProvider: provider,
+		purger:         mfxkit.Secret{ID: purgerKeyID, Hash: hash},
+	}
+}
+
+// purgerSecrets provides the service secrets followed by the purger secret.
+type purgerSecrets struct {
+	mfxkit.SecretProvider
+	purger mfxkit.Secret
+}
+
+func (ps purgerSecrets) Secrets() []mfxkit.Secret {
+	ss := ps.SecretProvider.Secrets()
+	return append(ss[:len(ss):len(ss)], ps.purger)
+}
+
+// startPurger periodically deletes kits whose retention period has expired,
+// authenticating with the given secret. Errors are logged by the service
+// logging middleware.
+func startPurger(svc mfxkit.Service, secret string, interval time.Duration, logger logger.Logger) {
+	if interval <= 0 {
+		logger.Info("Kits purger is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		svc.PurgeKits(context.Background(), secret)
+	}
+}
+
 func startHTTPServer(handler http.Handler, port string, cfg config, logger logger.Logger, errs chan error) {
 	p := fmt.Sprintf(":%s", port)
 	if cfg.serverCert != "" || cfg.serverKey != "" {
@@ -151,3 +857,30 @@ func startHTTPServer(handler http.Handler, port string, cfg config, logger logge
 	logger.Info(fmt.Sprintf("Mfxkit service started using http on port %s", cfg.httpPort))
 	errs <- http.ListenAndServe(p, handler)
 }
+
+func startGRPCServer(svc mfxkit.Service, tracer opentracing.Tracer, cfg config, logger logger.Logger, errs chan error) {
+	p := fmt.Sprintf(":%s", cfg.authGRPCPort)
+	listener, err := net.Listen("tcp", p)
+	if err != nil {
+		logger.Error(fmt.Sprintf("Failed to listen on port %s: %s", cfg.authGRPCPort, err))
+		os.Exit(1)
+	}
+
+	var server *grpc.Server
+	if cfg.serverCert != "" || cfg.serverKey != "" {
+		creds, err := credentials.NewServerTLSFromFile(cfg.serverCert, cfg.serverKey)
+		if err != nil {
+			logger.Error(fmt.Sprintf("Failed to load mfxkit certificates: %s", err))
+			os.Exit(1)
+		}
+		logger.Info(fmt.Sprintf("Mfxkit gRPC service started using https on port %s with cert %s key %s",
+			cfg.authGRPCPort, cfg.serverCert, cfg.serverKey))
+		server = grpc.NewServer(grpc.Creds(creds))
+	} else {
+		logger.Info(fmt.Sprintf("Mfxkit gRPC service started using http on port %s", cfg.authGRPCPort))
+		server = grpc.NewServer()
+	}
+
+	mfxkitgrpcapi.RegisterMfxkitServiceServer(server, mfxkitgrpcapi.NewServer(tracer, svc))
+	errs <- server.Serve(listener)
+}
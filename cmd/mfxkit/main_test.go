@@ -0,0 +1,271 @@
+//
+// Copyright (c) 2019
+// Mainflux
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// envTestArgs holds the command line arguments of the command run by
+// TestCommand in a subprocess, separated by spaces.
+const envTestArgs = "MFXKIT_TEST_ARGS"
+
+// TestCommand runs the command in a subprocess started by run, so that its
+// exit status and output can be checked.
+func TestCommand(t *testing.T) {
+	args := os.Getenv(envTestArgs)
+	if args == "" {
+		t.Skip("run as a subprocess only")
+	}
+
+	os.Args = append([]string{"mfxkit"}, strings.Fields(args)...)
+	main()
+	os.Exit(0)
+}
+
+// command returns the command running the given command line in a
+// subprocess with the given environment, ignoring the service variables of
+// the test environment.
+func command(env map[string]string, args ...string) *exec.Cmd {
+	cmd := exec.Command(os.Args[0], "-test.run=^TestCommand$")
+	for _, v := range os.Environ() {
+		if !strings.HasPrefix(v, "MF_") {
+			cmd.Env = append(cmd.Env, v)
+		}
+	}
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", envTestArgs, strings.Join(args, " ")))
+	for k, v := range env {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+	}
+
+	return cmd
+}
+
+// run runs the command line and returns its standard output and exit code.
+func run(t *testing.T, env map[string]string, args ...string) (string, int) {
+	var stdout bytes.Buffer
+	cmd := command(env, args...)
+	cmd.Stdout = &stdout
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return stdout.String(), exitErr.ExitCode()
+	}
+	require.Nil(t, err, fmt.Sprintf("run %s: unexpected error: %s", args, err))
+
+	return stdout.String(), 0
+}
+
+func freePort(t *testing.T) string {
+	l, err := net.Listen("tcp", "localhost:0")
+	require.Nil(t, err, fmt.Sprintf("listen: unexpected error: %s", err))
+	defer l.Close()
+
+	_, port, err := net.SplitHostPort(l.Addr().String())
+	require.Nil(t, err, fmt.Sprintf("listen: unexpected error: %s", err))
+
+	return port
+}
+
+func TestConfigPrint(t *testing.T) {
+	cases := []struct {
+		desc     string
+		env      map[string]string
+		contains []string
+		excludes []string
+		code     int
+	}{
+		{
+			desc:     "print default configuration",
+			env:      map[string]string{},
+			contains: []string{envHTTPPort + "=" + defHTTPPort, envSecret + "=" + redacted, envSecrets + "=\n", envDBPass + "=" + redacted},
+			excludes: []string{envSecret + "=" + defSecret + "\n", envDBPass + "=" + defDBPass + "\n"},
+		},
+		{
+			desc: "print configured secrets",
+			env: map[string]string{
+				envSecret:   "configured-service-secret",
+				envSecrets:  "key=configured-key-secret",
+				envDBPass:   "configured-db-pass",
+				envHTTPPort: "9999",
+			},
+			contains: []string{envHTTPPort + "=9999", envSecret + "=" + redacted, envSecrets + "=" + redacted, envDBPass + "=" + redacted},
+			excludes: []string{"configured-service-secret", "configured-key-secret", "configured-db-pass"},
+		},
+		{
+			desc: "print invalid configuration",
+			env:  map[string]string{envRetention: "invalid"},
+			code: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		out, code := run(t, tc.env, "config", "print")
+		assert.Equal(t, tc.code, code, fmt.Sprintf("%s: expected exit code %d got %d", tc.desc, tc.code, code))
+		for _, s := range tc.contains {
+			assert.Contains(t, out, s, fmt.Sprintf("%s: expected output to contain %s", tc.desc, s))
+		}
+		for _, s := range tc.excludes {
+			assert.NotContains(t, out, s, fmt.Sprintf("%s: expected output not to contain %s", tc.desc, s))
+		}
+	}
+}
+
+func TestHealthcheck(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer healthy.Close()
+
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Second)
+	}))
+	defer slow.Close()
+
+	tls := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer tls.Close()
+
+	cases := []struct {
+		desc string
+		env  map[string]string
+		args []string
+		code int
+	}{
+		{
+			desc: "check healthy service",
+			args: []string{"--url", healthy.URL},
+			code: 0,
+		},
+		{
+			desc: "check healthy service using self-signed certificate",
+			args: []string{"--url", tls.URL},
+			code: 0,
+		},
+		{
+			desc: "check unhealthy service",
+			args: []string{"--url", unhealthy.URL},
+			code: 1,
+		},
+		{
+			desc: "check service exceeding timeout",
+			args: []string{"--url", slow.URL, "--timeout", "100ms"},
+			code: 1,
+		},
+		{
+			desc: "check unreachable local service",
+			env:  map[string]string{envHTTPPort: freePort(t)},
+			code: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, code := run(t, tc.env, append([]string{"healthcheck"}, tc.args...)...)
+		assert.Equal(t, tc.code, code, fmt.Sprintf("%s: expected exit code %d got %d", tc.desc, tc.code, code))
+	}
+}
+
+func TestServe(t *testing.T) {
+	env := map[string]string{
+		envHTTPPort: freePort(t),
+		envGRPCPort: freePort(t),
+		envDevMode:  "true",
+	}
+
+	serve := command(env, "serve")
+	require.Nil(t, serve.Start(), "serve: unexpected error starting the service")
+	defer serve.Process.Kill()
+
+	url := localURL(config{httpPort: env[envHTTPPort]})
+	err := healthcheck(url, time.Second)
+	for deadline := time.Now().Add(10 * time.Second); err != nil && time.Now().Before(deadline); {
+		time.Sleep(100 * time.Millisecond)
+		err = healthcheck(url, time.Second)
+	}
+	require.Nil(t, err, fmt.Sprintf("serve: expected the service to start got %s", err))
+
+	_, code := run(t, env, "healthcheck")
+	assert.Equal(t, 0, code, fmt.Sprintf("healthcheck of the running service: expected exit code 0 got %d", code))
+
+	err = serve.Process.Signal(syscall.SIGTERM)
+	require.Nil(t, err, fmt.Sprintf("serve: unexpected error stopping the service: %s", err))
+	err = serve.Wait()
+	assert.Nil(t, err, fmt.Sprintf("serve: expected the service to stop got %s", err))
+}
+
+func TestServeRejectsDefaultSecret(t *testing.T) {
+	env := map[string]string{
+		envHTTPPort: freePort(t),
+		envGRPCPort: freePort(t),
+	}
+
+	_, code := run(t, env, "serve")
+	assert.Equal(t, 1, code, fmt.Sprintf("serve with default secret: expected exit code 1 got %d", code))
+}
+
+func TestMigrate(t *testing.T) {
+	env := map[string]string{
+		envLogLevel: "info",
+		envDBType:   "sqlite",
+		envDBName:   filepath.Join(t.TempDir(), "mfxkit.db"),
+	}
+
+	out, code := run(t, env, "migrate")
+	require.Equal(t, 0, code, fmt.Sprintf("migrate: expected exit code 0 got %d", code))
+	assert.NotContains(t, out, "Applied 0 database migrations", "migrate: expected the migrations to be applied")
+	assert.Contains(t, out, "database migrations", "migrate: expected the applied migrations to be reported")
+
+	out, code = run(t, env, "migrate")
+	require.Equal(t, 0, code, fmt.Sprintf("migrate again: expected exit code 0 got %d", code))
+	assert.Contains(t, out, "Applied 0 database migrations", "migrate again: expected no migration to be applied")
+
+	env[envDBType] = "invalid"
+	_, code = run(t, env, "migrate")
+	assert.Equal(t, 1, code, fmt.Sprintf("migrate unsupported database: expected exit code 1 got %d", code))
+}
+
+func TestVersion(t *testing.T) {
+	out, code := run(t, nil, "version")
+	require.Equal(t, 0, code, fmt.Sprintf("version: expected exit code 0 got %d", code))
+
+	for _, s := range []string{"version:    " + version, "commit:     " + commit, "go version: go"} {
+		assert.Contains(t, out, s, fmt.Sprintf("version: expected output to contain %s", s))
+	}
+}
+
+func TestHash(t *testing.T) {
+	out, code := run(t, nil, "hash", "--algorithm", "bcrypt", "a-strong-service-secret")
+	require.Equal(t, 0, code, fmt.Sprintf("hash: expected exit code 0 got %d", code))
+	assert.True(t, strings.HasPrefix(out, "$2"), fmt.Sprintf("hash: expected bcrypt hash got %s", out))
+	assert.NotContains(t, out, "a-strong-service-secret", "hash: expected the secret not to be printed")
+
+	_, code = run(t, nil, "hash", "--algorithm", "invalid", "a-strong-service-secret")
+	assert.Equal(t, 1, code, fmt.Sprintf("hash with invalid algorithm: expected exit code 1 got %d", code))
+}
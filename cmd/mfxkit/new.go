@@ -0,0 +1,53 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/mainflux/mfxkit/pkg/scaffold"
+	"github.com/spf13/cobra"
+)
+
+// The scaffolding command lives apart from main.go, which is part of the
+// template, so that the generated services do not contain it.
+func init() {
+	rootCmd.AddCommand(newScaffoldCmd())
+}
+
+func newScaffoldCmd() *cobra.Command {
+	cfg := scaffold.Config{
+		Module:   scaffold.DefModule,
+		HTTPPort: defHTTPPort,
+		GRPCPort: defGRPCPort,
+	}
+	var dir string
+
+	cmd := &cobra.Command{
+		Use:   "new <name>",
+		Short: "Create a new service from mfxkit",
+		Long: `Create a new service from mfxkit, with the module path, names, MF_<NAME>_ environment variables prefix, ` +
+			`metrics namespace and ports substituted. Files are written to <dir>/<name>, <dir>/cmd/<name> and <dir>/docker/<name>.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg.Name = args[0]
+			paths, err := scaffold.Generate(dir, cfg)
+			if err != nil {
+				return err
+			}
+
+			for _, p := range paths {
+				fmt.Println(p)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&dir, "dir", "d", ".", "Root directory of the target repository")
+	cmd.Flags().StringVarP(&cfg.Module, "module", "m", cfg.Module, "Module path of the target repository")
+	cmd.Flags().StringVar(&cfg.HTTPPort, "http-port", cfg.HTTPPort, "Default HTTP port of the service")
+	cmd.Flags().StringVar(&cfg.GRPCPort, "grpc-port", cfg.GRPCPort, "Default gRPC port of the service")
+
+	return cmd
+}
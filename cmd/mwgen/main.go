@@ -0,0 +1,83 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Command mwgen generates the logging, metrics and tracing middlewares of a
+// service interface. It is meant to be run using go:generate, e.g.
+//
+//	//go:generate go run github.com/mainflux/mfxkit/cmd/mwgen -src .. -iface Service
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+)
+
+func main() {
+	var (
+		src     = flag.String("src", ".", "Directory of the package declaring the service interface")
+		iface   = flag.String("iface", "Service", "Name of the service interface")
+		out     = flag.String("out", ".", "Output directory")
+		pkg     = flag.String("pkg", os.Getenv("GOPACKAGE"), "Package name of the generated code (default is the package running go:generate)")
+		tags    = flag.String("tags", "", "Build constraint of the generated files")
+		redacts = flag.String("redact", strings.Join(mwgen.DefRedacted, ","), "Comma-separated suffixes of parameter and field names whose values are redacted")
+		labels  = flag.String("labels", "", "Comma-separated name=Func[:param...] labels, where Func is the service package function tracking the label value, only for the methods with a parameter named like one of the param suffixes, if any")
+		skips   = flag.String("skip", "", "Comma-separated Type.Field struct fields that are neither logged nor traced")
+	)
+	flag.Parse()
+
+	if *pkg == "" {
+		abs, err := filepath.Abs(*out)
+		if err != nil {
+			exit(err)
+		}
+		*pkg = filepath.Base(abs)
+	}
+
+	svc, err := mwgen.Parse(*src, *iface)
+	if err != nil {
+		exit(err)
+	}
+
+	cfg := mwgen.Config{
+		Package:   *pkg,
+		BuildTags: *tags,
+	}
+	if *redacts != "" {
+		cfg.Redacted = strings.Split(*redacts, ",")
+	}
+	if *labels != "" {
+		for _, l := range strings.Split(*labels, ",") {
+			kv := strings.SplitN(l, "=", 2)
+			if len(kv) != 2 || kv[0] == "" || kv[1] == "" {
+				exit(fmt.Errorf("invalid label %q, expected name=Func[:param...]", l))
+			}
+			fp := strings.Split(kv[1], ":")
+			cfg.Labels = append(cfg.Labels, mwgen.Label{Name: kv[0], Func: fp[0], Params: fp[1:]})
+		}
+	}
+	if *skips != "" {
+		cfg.Skipped = strings.Split(*skips, ",")
+	}
+
+	files, err := mwgen.Generate(svc, cfg)
+	if err != nil {
+		exit(err)
+	}
+
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(*out, name), src, 0644); err != nil {
+			exit(err)
+		}
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintf(os.Stderr, "mwgen: %s\n", err)
+	os.Exit(1)
+}
@@ -4,3 +4,5 @@
 // Package api contains API-related concerns: endpoint definitions, middlewares
 // and all resource representations.
 package api
+
+//go:generate go run github.com/mainflux/mfxkit/cmd/mwgen -src .. -tags !test -labels key_id=TrackKeyID:secret:token -skip Kit.Owner,PageMetadata.Total
@@ -0,0 +1,39 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package api_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedMiddlewares fails if the committed middlewares differ from
+// the ones generated from the current service interface, e.g. after a
+// service error is added. Run go generate to update them.
+func TestGeneratedMiddlewares(t *testing.T) {
+	svc, err := mwgen.Parse("..", "Service")
+	require.Nil(t, err, fmt.Sprintf("parse service: unexpected error: %s", err))
+
+	// The config must match the go:generate directive of the package.
+	cfg := mwgen.Config{
+		Package:   "api",
+		BuildTags: "!test",
+		Redacted:  mwgen.DefRedacted,
+		Labels:    []mwgen.Label{{Name: "key_id", Func: "TrackKeyID", Params: []string{"secret", "token"}}},
+		Skipped:   []string{"Kit.Owner", "PageMetadata.Total"},
+	}
+	files, err := mwgen.Generate(svc, cfg)
+	require.Nil(t, err, fmt.Sprintf("generate middlewares: unexpected error: %s", err))
+
+	for name, src := range files {
+		committed, err := ioutil.ReadFile(name)
+		require.Nil(t, err, fmt.Sprintf("read %s: unexpected error: %s", name, err))
+		assert.Equal(t, string(src), string(committed), fmt.Sprintf("%s is stale, run go generate", name))
+	}
+}
@@ -1,11 +1,16 @@
 // Copyright (c) Mainflux
 // SPDX-License-Identifier: Apache-2.0
 
+// Code generated by mwgen. DO NOT EDIT.
+
+//go:build !test
 // +build !test
 
 package api
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,15 +30,147 @@ func LoggingMiddleware(svc mfxkit.Service, logger log.Logger) mfxkit.Service {
 	return &loggingMiddleware{logger, svc}
 }
 
-func (lm *loggingMiddleware) Ping(secret string) (response string, err error) {
+func (lm *loggingMiddleware) Ping(ctx context.Context, secret string) (greeting string, keyID string, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
 	defer func(begin time.Time) {
-		message := fmt.Sprintf("Method ping for secret %s took %s to complete", secret, time.Since(begin))
-		if err != nil {
-			lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
-			return
-		}
-		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+		message := fmt.Sprintf("Method ping for secret [REDACTED] and key_id %s took %s to complete", keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.Ping(ctx, secret)
+}
+
+func (lm *loggingMiddleware) CreateKits(ctx context.Context, token string, kits ...mfxkit.Kit) (res []mfxkit.Kit, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method create_kits for token [REDACTED], %d kits and key_id %s took %s to complete", len(kits), keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.CreateKits(ctx, token, kits...)
+}
+
+func (lm *loggingMiddleware) BulkCreateKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) (res []mfxkit.BulkResult, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method bulk_create_kits for token [REDACTED], atomic %t, %d kits, %d failed and key_id %s took %s to complete", atomic, len(kits), failedBulkResult(res), keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.BulkCreateKits(ctx, token, atomic, kits...)
+}
+
+func (lm *loggingMiddleware) ViewKit(ctx context.Context, token string, id string) (res mfxkit.Kit, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method view_kit for token [REDACTED], id %s and key_id %s took %s to complete", id, keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.ViewKit(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) UpdateKit(ctx context.Context, token string, kit mfxkit.Kit) (res mfxkit.Kit, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method update_kit for token [REDACTED], kit {ID: %s, Name: %s, Revision: %d} and key_id %s took %s to complete", kit.ID, kit.Name, kit.Revision, keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
 	}(time.Now())
 
-	return lm.svc.Ping(secret)
+	return lm.svc.UpdateKit(ctx, token, kit)
+}
+
+func (lm *loggingMiddleware) ListKits(ctx context.Context, token string, pm mfxkit.PageMetadata) (res mfxkit.Page, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method list_kits for token [REDACTED], pm {Offset: %d, Limit: %d, Order: %s, Dir: %s, Name: %s} and key_id %s took %s to complete", pm.Offset, pm.Limit, pm.Order, pm.Dir, pm.Name, keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.ListKits(ctx, token, pm)
+}
+
+func (lm *loggingMiddleware) RemoveKit(ctx context.Context, token string, id string, rev uint64) (err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method remove_kit for token [REDACTED], id %s, rev %d and key_id %s took %s to complete", id, rev, keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.RemoveKit(ctx, token, id, rev)
+}
+
+func (lm *loggingMiddleware) BulkRemoveKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) (res []mfxkit.BulkResult, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method bulk_remove_kits for token [REDACTED], atomic %t, %d kits, %d failed and key_id %s took %s to complete", atomic, len(kits), failedBulkResult(res), keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.BulkRemoveKits(ctx, token, atomic, kits...)
+}
+
+func (lm *loggingMiddleware) RestoreKit(ctx context.Context, token string, id string) (res mfxkit.Kit, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method restore_kit for token [REDACTED], id %s and key_id %s took %s to complete", id, keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.RestoreKit(ctx, token, id)
+}
+
+func (lm *loggingMiddleware) PurgeKits(ctx context.Context, secret string) (res uint64, err error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("Method purge_kits for secret [REDACTED] and key_id %s took %s to complete", keyIDLabel(), time.Since(begin))
+		lm.log(message, err)
+	}(time.Now())
+
+	return lm.svc.PurgeKits(ctx, secret)
+}
+
+// log logs the outcome of the method, using the warning level for the
+// errors expected to be returned by the service and the error level for
+// all the others.
+func (lm *loggingMiddleware) log(message string, err error) {
+	switch {
+	case err == nil:
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+	case expectedError(err):
+		lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+	default:
+		lm.logger.Error(fmt.Sprintf("%s with error: %s.", message, err))
+	}
+}
+
+func expectedError(err error) bool {
+	expected := []error{
+		context.Canceled,
+		context.DeadlineExceeded,
+		mfxkit.ErrBulkAborted,
+		mfxkit.ErrConflict,
+		mfxkit.ErrForbidden,
+		mfxkit.ErrMalformedEntity,
+		mfxkit.ErrNotFound,
+		mfxkit.ErrUnauthorizedAccess,
+	}
+	for _, e := range expected {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func failedBulkResult(res []mfxkit.BulkResult) int {
+	n := 0
+	for _, r := range res {
+		if r.Err != nil {
+			n++
+		}
+	}
+
+	return n
 }
@@ -1,11 +1,15 @@
 // Copyright (c) Mainflux
 // SPDX-License-Identifier: Apache-2.0
 
+// Code generated by mwgen. DO NOT EDIT.
+
+//go:build !test
 // +build !test
 
 package api
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-kit/kit/metrics"
@@ -17,24 +21,121 @@ var _ mfxkit.Service = (*metricsMiddleware)(nil)
 type metricsMiddleware struct {
 	counter metrics.Counter
 	latency metrics.Histogram
+	items   metrics.Counter
 	svc     mfxkit.Service
 }
 
 // MetricsMiddleware instruments core service by tracking request count and
-// latency.
-func MetricsMiddleware(svc mfxkit.Service, counter metrics.Counter, latency metrics.Histogram) mfxkit.Service {
+// latency, as well as the number of items processed by the requests.
+// Requests are counted by method and key_id.
+func MetricsMiddleware(svc mfxkit.Service, counter metrics.Counter, latency metrics.Histogram, items metrics.Counter) mfxkit.Service {
 	return &metricsMiddleware{
 		counter: counter,
 		latency: latency,
+		items:   items,
 		svc:     svc,
 	}
 }
 
-func (ms *metricsMiddleware) Ping(secret string) (response string, err error) {
+func (ms *metricsMiddleware) Ping(ctx context.Context, secret string) (string, string, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
 	defer func(begin time.Time) {
-		ms.counter.With("method", "ping").Add(1)
+		ms.counter.With("method", "ping", "key_id", keyIDLabel()).Add(1)
 		ms.latency.With("method", "ping").Observe(time.Since(begin).Seconds())
 	}(time.Now())
 
-	return ms.svc.Ping(secret)
+	return ms.svc.Ping(ctx, secret)
+}
+
+func (ms *metricsMiddleware) CreateKits(ctx context.Context, token string, kits ...mfxkit.Kit) ([]mfxkit.Kit, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "create_kits", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "create_kits").Observe(time.Since(begin).Seconds())
+		ms.items.With("method", "create_kits").Add(float64(len(kits)))
+	}(time.Now())
+
+	return ms.svc.CreateKits(ctx, token, kits...)
+}
+
+func (ms *metricsMiddleware) BulkCreateKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) ([]mfxkit.BulkResult, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "bulk_create_kits", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "bulk_create_kits").Observe(time.Since(begin).Seconds())
+		ms.items.With("method", "bulk_create_kits").Add(float64(len(kits)))
+	}(time.Now())
+
+	return ms.svc.BulkCreateKits(ctx, token, atomic, kits...)
+}
+
+func (ms *metricsMiddleware) ViewKit(ctx context.Context, token string, id string) (mfxkit.Kit, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "view_kit", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "view_kit").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ViewKit(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) UpdateKit(ctx context.Context, token string, kit mfxkit.Kit) (mfxkit.Kit, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "update_kit", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "update_kit").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.UpdateKit(ctx, token, kit)
+}
+
+func (ms *metricsMiddleware) ListKits(ctx context.Context, token string, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "list_kits", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "list_kits").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.ListKits(ctx, token, pm)
+}
+
+func (ms *metricsMiddleware) RemoveKit(ctx context.Context, token string, id string, rev uint64) error {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "remove_kit", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "remove_kit").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RemoveKit(ctx, token, id, rev)
+}
+
+func (ms *metricsMiddleware) BulkRemoveKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) ([]mfxkit.BulkResult, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "bulk_remove_kits", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "bulk_remove_kits").Observe(time.Since(begin).Seconds())
+		ms.items.With("method", "bulk_remove_kits").Add(float64(len(kits)))
+	}(time.Now())
+
+	return ms.svc.BulkRemoveKits(ctx, token, atomic, kits...)
+}
+
+func (ms *metricsMiddleware) RestoreKit(ctx context.Context, token string, id string) (mfxkit.Kit, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "restore_kit", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "restore_kit").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.RestoreKit(ctx, token, id)
+}
+
+func (ms *metricsMiddleware) PurgeKits(ctx context.Context, secret string) (uint64, error) {
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func(begin time.Time) {
+		ms.counter.With("method", "purge_kits", "key_id", keyIDLabel()).Add(1)
+		ms.latency.With("method", "purge_kits").Observe(time.Since(begin).Seconds())
+	}(time.Now())
+
+	return ms.svc.PurgeKits(ctx, secret)
 }
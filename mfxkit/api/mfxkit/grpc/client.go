@@ -0,0 +1,427 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	kitlog "github.com/go-kit/kit/log"
+	kitot "github.com/go-kit/kit/tracing/opentracing"
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"github.com/mainflux/mfxkit/mfxkit"
+	opentracing "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	svcName = "mfxkit.MfxkitService"
+
+	// chanIDKey is the metadata key of the channel the thing requests
+	// access through.
+	chanIDKey = "channel-id"
+)
+
+// knownErrs maps messages of the errors reported by the server to the
+// service errors.
+var knownErrs = map[string]error{
+	mfxkit.ErrMalformedEntity.Error():    mfxkit.ErrMalformedEntity,
+	mfxkit.ErrUnauthorizedAccess.Error(): mfxkit.ErrUnauthorizedAccess,
+	mfxkit.ErrForbidden.Error():          mfxkit.ErrForbidden,
+	mfxkit.ErrNotFound.Error():           mfxkit.ErrNotFound,
+	mfxkit.ErrConflict.Error():           mfxkit.ErrConflict,
+	mfxkit.ErrBulkAborted.Error():        mfxkit.ErrBulkAborted,
+	context.Canceled.Error():             context.Canceled,
+	context.DeadlineExceeded.Error():     context.DeadlineExceeded,
+}
+
+var _ mfxkit.Service = (*grpcClient)(nil)
+
+type grpcClient struct {
+	timeout        time.Duration
+	ping           endpoint.Endpoint
+	createKits     endpoint.Endpoint
+	bulkCreateKits endpoint.Endpoint
+	viewKit        endpoint.Endpoint
+	updateKit      endpoint.Endpoint
+	listKits       endpoint.Endpoint
+	removeKit      endpoint.Endpoint
+	bulkRemoveKits endpoint.Endpoint
+	restoreKit     endpoint.Endpoint
+	purgeKits      endpoint.Endpoint
+}
+
+// NewClient returns new gRPC client instance. Every call is bounded by the
+// given timeout, unless it is not positive, and its span is propagated to
+// the server. Kits returned by the client do not carry the owner, as it is
+// never sent over the wire.
+func NewClient(conn *grpc.ClientConn, tracer opentracing.Tracer, timeout time.Duration) mfxkit.Service {
+	return &grpcClient{
+		timeout: timeout,
+		ping: newClientEndpoint(conn, tracer, "ping", "Ping",
+			encodePingRequest, decodePingResponse, PingRes{}),
+		createKits: newClientEndpoint(conn, tracer, "create_kits", "CreateKits",
+			encodeCreateKitsRequest, decodeKitsResponse, KitsRes{}),
+		bulkCreateKits: newClientEndpoint(conn, tracer, "bulk_create_kits", "BulkCreateKits",
+			encodeBulkCreateKitsRequest, decodeBulkResponse, BulkRes{}),
+		viewKit: newClientEndpoint(conn, tracer, "view_kit", "ViewKit",
+			encodeKitRequest, decodeKitResponse, Kit{}),
+		updateKit: newClientEndpoint(conn, tracer, "update_kit", "UpdateKit",
+			encodeUpdateKitRequest, decodeKitResponse, Kit{}),
+		listKits: newClientEndpoint(conn, tracer, "list_kits", "ListKits",
+			encodeListKitsRequest, decodeKitsPageResponse, KitsPage{}),
+		removeKit: newClientEndpoint(conn, tracer, "remove_kit", "RemoveKit",
+			encodeKitRequest, decodeEmptyResponse, emptypb.Empty{}),
+		bulkRemoveKits: newClientEndpoint(conn, tracer, "bulk_remove_kits", "BulkRemoveKits",
+			encodeBulkRemoveKitsRequest, decodeBulkResponse, BulkRes{}),
+		restoreKit: newClientEndpoint(conn, tracer, "restore_kit", "RestoreKit",
+			encodeKitRequest, decodeKitResponse, Kit{}),
+		purgeKits: newClientEndpoint(conn, tracer, "purge_kits", "PurgeKits",
+			encodePurgeKitsRequest, decodePurgeResponse, PurgeRes{}),
+	}
+}
+
+func newClientEndpoint(conn *grpc.ClientConn, tracer opentracing.Tracer, operation, method string, enc kitgrpc.EncodeRequestFunc, dec kitgrpc.DecodeResponseFunc, reply interface{}) endpoint.Endpoint {
+	return kitot.TraceClient(tracer, operation)(kitgrpc.NewClient(
+		conn,
+		svcName,
+		method,
+		enc,
+		dec,
+		reply,
+		kitgrpc.ClientBefore(kitot.ContextToGRPC(tracer, kitlog.NewNopLogger())),
+		kitgrpc.ClientBefore(channelToGRPC),
+	).Endpoint())
+}
+
+// withTimeout bounds the call by the client timeout. Calls of the clients
+// created without a timeout are bounded only by the caller's context.
+func (client grpcClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if client.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, client.timeout)
+}
+
+// channelToGRPC sends the ID of the channel carried by the context, if any.
+func channelToGRPC(ctx context.Context, md *metadata.MD) context.Context {
+	if chanID, ok := mfxkit.ChannelIDFromContext(ctx); ok {
+		md.Set(chanIDKey, chanID)
+	}
+
+	return ctx
+}
+
+func (client grpcClient) Ping(ctx context.Context, secret string) (string, string, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.ping(ctx, pingReq{secret: secret})
+	if err != nil {
+		return "", "", decodeError(err)
+	}
+
+	pr := res.(pingRes)
+	return pr.greeting, pr.keyID, nil
+}
+
+func (client grpcClient) CreateKits(ctx context.Context, token string, kits ...mfxkit.Kit) ([]mfxkit.Kit, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.createKits(ctx, createKitsReq{token: token, kits: kits})
+	if err != nil {
+		return []mfxkit.Kit{}, decodeError(err)
+	}
+
+	return res.(kitsRes).kits, nil
+}
+
+func (client grpcClient) BulkCreateKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) ([]mfxkit.BulkResult, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.bulkCreateKits(ctx, createKitsReq{token: token, atomic: atomic, kits: kits})
+	if err != nil {
+		return []mfxkit.BulkResult{}, decodeError(err)
+	}
+
+	return res.(bulkRes).results, nil
+}
+
+func (client grpcClient) ViewKit(ctx context.Context, token, id string) (mfxkit.Kit, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.viewKit(ctx, kitReq{token: token, id: id})
+	if err != nil {
+		return mfxkit.Kit{}, decodeError(err)
+	}
+
+	return res.(kitRes).kit, nil
+}
+
+func (client grpcClient) UpdateKit(ctx context.Context, token string, kit mfxkit.Kit) (mfxkit.Kit, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.updateKit(ctx, updateKitReq{token: token, kit: kit})
+	if err != nil {
+		return mfxkit.Kit{}, decodeError(err)
+	}
+
+	return res.(kitRes).kit, nil
+}
+
+func (client grpcClient) ListKits(ctx context.Context, token string, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.listKits(ctx, listKitsReq{token: token, pageMetadata: pm})
+	if err != nil {
+		return mfxkit.Page{}, decodeError(err)
+	}
+
+	return res.(kitsPageRes).page, nil
+}
+
+func (client grpcClient) RemoveKit(ctx context.Context, token, id string, rev uint64) error {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	if _, err := client.removeKit(ctx, kitReq{token: token, id: id, revision: rev}); err != nil {
+		return decodeError(err)
+	}
+
+	return nil
+}
+
+func (client grpcClient) BulkRemoveKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) ([]mfxkit.BulkResult, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.bulkRemoveKits(ctx, removeKitsReq{token: token, atomic: atomic, kits: kits})
+	if err != nil {
+		return []mfxkit.BulkResult{}, decodeError(err)
+	}
+
+	return res.(bulkRes).results, nil
+}
+
+func (client grpcClient) RestoreKit(ctx context.Context, token, id string) (mfxkit.Kit, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.restoreKit(ctx, kitReq{token: token, id: id})
+	if err != nil {
+		return mfxkit.Kit{}, decodeError(err)
+	}
+
+	return res.(kitRes).kit, nil
+}
+
+func (client grpcClient) PurgeKits(ctx context.Context, secret string) (uint64, error) {
+	ctx, cancel := client.withTimeout(ctx)
+	defer cancel()
+
+	res, err := client.purgeKits(ctx, purgeKitsReq{secret: secret})
+	if err != nil {
+		return 0, decodeError(err)
+	}
+
+	return res.(purgeRes).count, nil
+}
+
+func encodePingRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(pingReq)
+	return &PingReq{Secret: req.secret}, nil
+}
+
+func encodeCreateKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createKitsReq)
+	kits, err := encodeKits(req.kits)
+	if err != nil {
+		return nil, mfxkit.ErrMalformedEntity
+	}
+
+	return &CreateKitsReq{Token: req.token, Kits: kits}, nil
+}
+
+func encodeBulkCreateKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(createKitsReq)
+	kits, err := encodeKits(req.kits)
+	if err != nil {
+		return nil, mfxkit.ErrMalformedEntity
+	}
+
+	return &BulkReq{Token: req.token, Atomic: req.atomic, Kits: kits}, nil
+}
+
+func encodeBulkRemoveKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(removeKitsReq)
+	kits := make([]*Kit, len(req.kits))
+	for i, kit := range req.kits {
+		kits[i] = &Kit{Id: kit.ID, Revision: kit.Revision}
+	}
+
+	return &BulkReq{Token: req.token, Atomic: req.atomic, Kits: kits}, nil
+}
+
+func encodeKitRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(kitReq)
+	return &KitReq{Token: req.token, Id: req.id, Revision: req.revision}, nil
+}
+
+func encodeUpdateKitRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(updateKitReq)
+	kit, err := encodeKit(req.kit)
+	if err != nil {
+		return nil, mfxkit.ErrMalformedEntity
+	}
+
+	return &UpdateKitReq{Token: req.token, Kit: kit}, nil
+}
+
+func encodeListKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(listKitsReq)
+	metadata, err := encodeMetadata(req.pageMetadata.Metadata)
+	if err != nil {
+		return nil, mfxkit.ErrMalformedEntity
+	}
+
+	return &ListKitsReq{
+		Token:    req.token,
+		Offset:   req.pageMetadata.Offset,
+		Limit:    req.pageMetadata.Limit,
+		Order:    req.pageMetadata.Order,
+		Dir:      req.pageMetadata.Dir,
+		Name:     req.pageMetadata.Name,
+		Metadata: metadata,
+	}, nil
+}
+
+func encodePurgeKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(purgeKitsReq)
+	return &PurgeKitsReq{Secret: req.secret}, nil
+}
+
+func decodePingResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*PingRes)
+	return pingRes{greeting: res.GetGreeting(), keyID: res.GetKeyId()}, nil
+}
+
+func decodeKitResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	kit, err := decodeKit(grpcRes.(*Kit))
+	if err != nil {
+		return nil, err
+	}
+
+	return kitRes{kit: kit}, nil
+}
+
+func decodeKitsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*KitsRes)
+	kits, err := decodeKits(res.GetKits())
+	if err != nil {
+		return nil, err
+	}
+
+	return kitsRes{kits: kits}, nil
+}
+
+func decodeKitsPageResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*KitsPage)
+	kits, err := decodeKits(res.GetKits())
+	if err != nil {
+		return nil, err
+	}
+
+	page := mfxkit.Page{
+		PageMetadata: mfxkit.PageMetadata{
+			Total:  res.GetTotal(),
+			Offset: res.GetOffset(),
+			Limit:  res.GetLimit(),
+			Order:  res.GetOrder(),
+			Dir:    res.GetDir(),
+		},
+		Kits: kits,
+	}
+
+	return kitsPageRes{page: page}, nil
+}
+
+func decodeBulkResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*BulkRes)
+	results := make([]mfxkit.BulkResult, len(res.GetResults()))
+	for i, r := range res.GetResults() {
+		kit := mfxkit.Kit{}
+		if r.GetKit() != nil {
+			k, err := decodeKit(r.GetKit())
+			if err != nil {
+				return nil, err
+			}
+			kit = k
+		}
+
+		results[i] = mfxkit.BulkResult{Kit: kit}
+		if msg := r.GetError(); msg != "" {
+			results[i].Err = decodeErrorMessage(msg)
+		}
+	}
+
+	return bulkRes{results: results}, nil
+}
+
+func decodeEmptyResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return removeRes{}, nil
+}
+
+func decodePurgeResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(*PurgeRes)
+	return purgeRes{count: res.GetCount()}, nil
+}
+
+// decodeError converts the gRPC status error to the service error.
+func decodeError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return mfxkit.ErrMalformedEntity
+	case codes.Unauthenticated:
+		return mfxkit.ErrUnauthorizedAccess
+	case codes.PermissionDenied:
+		return mfxkit.ErrForbidden
+	case codes.NotFound:
+		return mfxkit.ErrNotFound
+	case codes.Aborted:
+		return mfxkit.ErrConflict
+	case codes.FailedPrecondition:
+		return mfxkit.ErrBulkAborted
+	case codes.Canceled:
+		return context.Canceled
+	case codes.DeadlineExceeded:
+		return context.DeadlineExceeded
+	default:
+		return err
+	}
+}
+
+func decodeErrorMessage(msg string) error {
+	if err, ok := knownErrs[msg]; ok {
+		return err
+	}
+
+	return errors.New(msg)
+}
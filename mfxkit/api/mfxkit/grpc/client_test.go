@@ -0,0 +1,34 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithTimeout(t *testing.T) {
+	cases := []struct {
+		desc     string
+		timeout  time.Duration
+		deadline bool
+	}{
+		{"positive timeout", time.Second, true},
+		{"zero timeout", 0, false},
+		{"negative timeout", -time.Second, false},
+	}
+
+	for _, tc := range cases {
+		client := grpcClient{timeout: tc.timeout}
+		ctx, cancel := client.withTimeout(context.Background())
+		_, ok := ctx.Deadline()
+		assert.Equal(t, tc.deadline, ok, fmt.Sprintf("%s: expected deadline %t got %t", tc.desc, tc.deadline, ok))
+		assert.Nil(t, ctx.Err(), fmt.Sprintf("%s: expected live context got %s", tc.desc, ctx.Err()))
+		cancel()
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+func pingEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(pingReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		greeting, keyID, err := svc.Ping(ctx, req.secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return pingRes{greeting: greeting, keyID: keyID}, nil
+	}
+}
+
+func createKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createKitsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		kits, err := svc.CreateKits(ctx, req.token, req.kits...)
+		if err != nil {
+			return nil, err
+		}
+
+		return kitsRes{kits: kits}, nil
+	}
+}
+
+func bulkCreateKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createKitsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		results, err := svc.BulkCreateKits(ctx, req.token, req.atomic, req.kits...)
+		if err != nil {
+			return nil, err
+		}
+
+		return bulkRes{results: results}, nil
+	}
+}
+
+func viewKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(kitReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		kit, err := svc.ViewKit(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return kitRes{kit: kit}, nil
+	}
+}
+
+func updateKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateKitReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		kit, err := svc.UpdateKit(ctx, req.token, req.kit)
+		if err != nil {
+			return nil, err
+		}
+
+		return kitRes{kit: kit}, nil
+	}
+}
+
+func listKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listKitsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListKits(ctx, req.token, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		return kitsPageRes{page: page}, nil
+	}
+}
+
+func removeKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(kitReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveKit(ctx, req.token, req.id, req.revision); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func bulkRemoveKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeKitsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		results, err := svc.BulkRemoveKits(ctx, req.token, req.atomic, req.kits...)
+		if err != nil {
+			return nil, err
+		}
+
+		return bulkRes{results: results}, nil
+	}
+}
+
+func restoreKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(kitReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		kit, err := svc.RestoreKit(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return kitRes{kit: kit}, nil
+	}
+}
+
+func purgeKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(purgeKitsReq)
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		count, err := svc.PurgeKits(ctx, req.secret)
+		if err != nil {
+			return nil, err
+		}
+
+		return purgeRes{count: count}, nil
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	mfxkitgrpc "github.com/mainflux/mfxkit/mfxkit/api/mfxkit/grpc"
+	"github.com/mainflux/mfxkit/mfxkit/mocks"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const (
+	token   = "token"
+	owner   = "user"
+	timeout = time.Second
+	bufSize = 1024 * 1024
+)
+
+// channelService records the channel the last call was made through.
+type channelService struct {
+	*mocks.Service
+	mu     sync.Mutex
+	chanID string
+}
+
+func (cs *channelService) ViewKit(ctx context.Context, token, id string) (mfxkit.Kit, error) {
+	cs.mu.Lock()
+	cs.chanID, _ = mfxkit.ChannelIDFromContext(ctx)
+	cs.mu.Unlock()
+
+	return cs.Service.ViewKit(ctx, token, id)
+}
+
+func (cs *channelService) channel() string {
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+
+	return cs.chanID
+}
+
+// newClient serves the service over an in-memory connection and returns
+// the client of the served service.
+func newClient(t *testing.T, svc mfxkit.Service) mfxkit.Service {
+	listener := bufconn.Listen(bufSize)
+	server := grpc.NewServer()
+	mfxkitgrpc.RegisterMfxkitServiceServer(server, mfxkitgrpc.NewServer(opentracing.NoopTracer{}, svc))
+	go server.Serve(listener)
+
+	dialer := func(context.Context, string) (net.Conn, error) {
+		return listener.Dial()
+	}
+	conn, err := grpc.DialContext(context.Background(), "bufnet", grpc.WithContextDialer(dialer), grpc.WithInsecure())
+	require.Nil(t, err, fmt.Sprintf("dial server: unexpected error: %s", err))
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+	})
+
+	return mfxkitgrpc.NewClient(conn, opentracing.NoopTracer{}, timeout)
+}
+
+func TestErrors(t *testing.T) {
+	svc := mocks.NewService(map[string]string{token: owner})
+	client := newClient(t, svc)
+
+	cases := []struct {
+		desc string
+		err  error
+		want error
+	}{
+		{"view kit failing with malformed entity", mfxkit.ErrMalformedEntity, mfxkit.ErrMalformedEntity},
+		{"view kit failing with unauthorized access", mfxkit.ErrUnauthorizedAccess, mfxkit.ErrUnauthorizedAccess},
+		{"view kit failing with forbidden access", mfxkit.ErrForbidden, mfxkit.ErrForbidden},
+		{"view kit failing with not found", mfxkit.ErrNotFound, mfxkit.ErrNotFound},
+		{"view kit failing with wrapped not found", fmt.Errorf("retrieve kit: %w", mfxkit.ErrNotFound), mfxkit.ErrNotFound},
+		{"view kit failing with conflict", mfxkit.ErrConflict, mfxkit.ErrConflict},
+		{"view kit failing with aborted bulk operation", mfxkit.ErrBulkAborted, mfxkit.ErrBulkAborted},
+		{"view kit failing with canceled context", context.Canceled, context.Canceled},
+		{"view kit failing with exceeded deadline", context.DeadlineExceeded, context.DeadlineExceeded},
+	}
+
+	for _, tc := range cases {
+		svc.Reset()
+		svc.Script("ViewKit", mocks.Behaviour{Err: tc.err})
+
+		_, err := client.ViewKit(context.Background(), token, "id")
+		assert.Equal(t, tc.want, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.want, err))
+	}
+
+	svc.Reset()
+	svc.Script("ViewKit", mocks.Behaviour{Err: errors.New("database error")})
+	_, err := client.ViewKit(context.Background(), token, "id")
+	assert.Equal(t, codes.Internal, status.Code(err), fmt.Sprintf("view kit failing with unknown error: expected code %s got %s", codes.Internal, status.Code(err)))
+	assert.NotContains(t, fmt.Sprint(err), "database error", "view kit failing with unknown error: expected the cause not to be exposed")
+}
+
+func TestBulkItemErrors(t *testing.T) {
+	svc := mocks.NewService(map[string]string{token: owner})
+	client := newClient(t, svc)
+
+	svc.Script("BulkRemoveKits", mocks.Behaviour{Result: []mfxkit.BulkResult{
+		{Kit: mfxkit.Kit{ID: "removed"}},
+		{Kit: mfxkit.Kit{ID: "aborted"}, Err: mfxkit.ErrBulkAborted},
+		{Kit: mfxkit.Kit{ID: "conflict"}, Err: fmt.Errorf("remove kit: %w", mfxkit.ErrConflict)},
+		{Kit: mfxkit.Kit{ID: "failed"}, Err: errors.New("pq: connection refused")},
+	}})
+
+	res, err := client.BulkRemoveKits(context.Background(), token, true, mfxkit.Kit{ID: "removed"}, mfxkit.Kit{ID: "aborted"}, mfxkit.Kit{ID: "conflict"}, mfxkit.Kit{ID: "failed"})
+	require.Nil(t, err, fmt.Sprintf("remove kits: unexpected error: %s", err))
+
+	expected := []error{nil, mfxkit.ErrBulkAborted, mfxkit.ErrConflict, errors.New("internal error")}
+	require.Len(t, res, len(expected), fmt.Sprintf("remove kits: expected %d results got %d", len(expected), len(res)))
+	for i, r := range res {
+		assert.Equal(t, expected[i], r.Err, fmt.Sprintf("remove kits: item %d: expected %v got %v", i, expected[i], r.Err))
+	}
+}
+
+func TestChannelPropagation(t *testing.T) {
+	svc := &channelService{Service: mocks.NewService(map[string]string{token: owner})}
+	svc.Script("ViewKit", mocks.Behaviour{Result: mfxkit.Kit{ID: "id"}})
+	client := newClient(t, svc)
+
+	cases := []struct {
+		desc   string
+		ctx    context.Context
+		chanID string
+	}{
+		{"view kit through channel", mfxkit.WithChannelID(context.Background(), "channel"), "channel"},
+		{"view kit without channel", context.Background(), ""},
+	}
+
+	for _, tc := range cases {
+		_, err := client.ViewKit(tc.ctx, token, "id")
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.chanID, svc.channel(), fmt.Sprintf("%s: expected channel %s got %s", tc.desc, tc.chanID, svc.channel()))
+	}
+}
+
+func TestTimestamps(t *testing.T) {
+	svc := mocks.NewService(map[string]string{token: owner})
+	client := newClient(t, svc)
+
+	created := time.Date(2021, time.March, 1, 12, 30, 45, 123456789, time.UTC)
+	cases := []struct {
+		desc string
+		kit  mfxkit.Kit
+	}{
+		{"view kit with timestamps", mfxkit.Kit{ID: "id", Revision: 2, CreatedAt: created, UpdatedAt: created.Add(time.Hour)}},
+		{"view kit with local timestamps", mfxkit.Kit{ID: "id", Revision: 1, CreatedAt: created.In(time.FixedZone("CET", 3600)), UpdatedAt: created}},
+		{"view kit without timestamps", mfxkit.Kit{ID: "id", Revision: 1}},
+	}
+
+	for _, tc := range cases {
+		svc.Reset()
+		svc.Script("ViewKit", mocks.Behaviour{Result: tc.kit})
+
+		kit, err := client.ViewKit(context.Background(), token, "id")
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.True(t, tc.kit.CreatedAt.Equal(kit.CreatedAt), fmt.Sprintf("%s: expected created at %s got %s", tc.desc, tc.kit.CreatedAt, kit.CreatedAt))
+		assert.True(t, tc.kit.UpdatedAt.Equal(kit.UpdatedAt), fmt.Sprintf("%s: expected updated at %s got %s", tc.desc, tc.kit.UpdatedAt, kit.UpdatedAt))
+		assert.Equal(t, kit.CreatedAt.UTC(), kit.CreatedAt, fmt.Sprintf("%s: expected UTC timestamps got %s", tc.desc, kit.CreatedAt.Location()))
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+// decodeKit converts the protobuf kit to the domain kit.
+func decodeKit(kit *Kit) (mfxkit.Kit, error) {
+	metadata, err := decodeMetadata(kit.GetMetadata())
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return mfxkit.Kit{
+		ID:        kit.GetId(),
+		Name:      kit.GetName(),
+		Metadata:  metadata,
+		Revision:  kit.GetRevision(),
+		CreatedAt: decodeTime(kit.GetCreatedAt()),
+		UpdatedAt: decodeTime(kit.GetUpdatedAt()),
+	}, nil
+}
+
+func decodeKits(kits []*Kit) ([]mfxkit.Kit, error) {
+	res := make([]mfxkit.Kit, len(kits))
+	for i, kit := range kits {
+		k, err := decodeKit(kit)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = k
+	}
+
+	return res, nil
+}
+
+// encodeKit converts the domain kit to the protobuf kit.
+func encodeKit(kit mfxkit.Kit) (*Kit, error) {
+	metadata, err := encodeMetadata(kit.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Kit{
+		Id:        kit.ID,
+		Name:      kit.Name,
+		Metadata:  metadata,
+		Revision:  kit.Revision,
+		CreatedAt: encodeTime(kit.CreatedAt),
+		UpdatedAt: encodeTime(kit.UpdatedAt),
+	}, nil
+}
+
+func encodeKits(kits []mfxkit.Kit) ([]*Kit, error) {
+	res := make([]*Kit, len(kits))
+	for i, kit := range kits {
+		k, err := encodeKit(kit)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = k
+	}
+
+	return res, nil
+}
+
+func decodeMetadata(data []byte) (mfxkit.Metadata, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var metadata mfxkit.Metadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, mfxkit.ErrMalformedEntity
+	}
+
+	return metadata, nil
+}
+
+func encodeMetadata(metadata mfxkit.Metadata) ([]byte, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(metadata)
+}
+
+func decodeTime(nsec int64) time.Time {
+	if nsec == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, nsec).UTC()
+}
+
+func encodeTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
@@ -0,0 +1,135 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import "github.com/mainflux/mfxkit/mfxkit"
+
+const (
+	maxNameSize = 1024
+	maxBulkSize = 1000
+)
+
+type pingReq struct {
+	secret string
+}
+
+func (req pingReq) validate() error {
+	if req.secret == "" {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type createKitsReq struct {
+	token  string
+	atomic bool
+	kits   []mfxkit.Kit
+}
+
+func (req createKitsReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if len(req.kits) == 0 || len(req.kits) > maxBulkSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	for _, kit := range req.kits {
+		if len(kit.Name) > maxNameSize {
+			return mfxkit.ErrMalformedEntity
+		}
+	}
+
+	return nil
+}
+
+type removeKitsReq struct {
+	token  string
+	atomic bool
+	kits   []mfxkit.Kit
+}
+
+func (req removeKitsReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if len(req.kits) == 0 || len(req.kits) > maxBulkSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	for _, kit := range req.kits {
+		if kit.ID == "" {
+			return mfxkit.ErrMalformedEntity
+		}
+	}
+
+	return nil
+}
+
+type kitReq struct {
+	token    string
+	id       string
+	revision uint64
+}
+
+func (req kitReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if req.id == "" {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type updateKitReq struct {
+	token string
+	kit   mfxkit.Kit
+}
+
+func (req updateKitReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if req.kit.ID == "" || len(req.kit.Name) > maxNameSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type listKitsReq struct {
+	token        string
+	pageMetadata mfxkit.PageMetadata
+}
+
+func (req listKitsReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if len(req.pageMetadata.Name) > maxNameSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return req.pageMetadata.Validate()
+}
+
+type purgeKitsReq struct {
+	secret string
+}
+
+func (req purgeKitsReq) validate() error {
+	if req.secret == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
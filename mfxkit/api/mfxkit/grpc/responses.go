@@ -0,0 +1,33 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import "github.com/mainflux/mfxkit/mfxkit"
+
+type pingRes struct {
+	greeting string
+	keyID    string
+}
+
+type kitRes struct {
+	kit mfxkit.Kit
+}
+
+type kitsRes struct {
+	kits []mfxkit.Kit
+}
+
+type kitsPageRes struct {
+	page mfxkit.Page
+}
+
+type bulkRes struct {
+	results []mfxkit.BulkResult
+}
+
+type removeRes struct{}
+
+type purgeRes struct {
+	count uint64
+}
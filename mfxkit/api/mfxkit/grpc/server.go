@@ -0,0 +1,357 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-kit/kit/endpoint"
+	kitlog "github.com/go-kit/kit/log"
+	kitot "github.com/go-kit/kit/tracing/opentracing"
+	kitgrpc "github.com/go-kit/kit/transport/grpc"
+	"github.com/mainflux/mfxkit/mfxkit"
+	opentracing "github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// errInternal replaces the errors of bulk items that are not meant to be
+// exposed to the clients, such as the database driver errors.
+var errInternal = errors.New("internal error")
+
+var _ MfxkitServiceServer = (*grpcServer)(nil)
+
+type grpcServer struct {
+	ping           kitgrpc.Handler
+	createKits     kitgrpc.Handler
+	bulkCreateKits kitgrpc.Handler
+	viewKit        kitgrpc.Handler
+	updateKit      kitgrpc.Handler
+	listKits       kitgrpc.Handler
+	removeKit      kitgrpc.Handler
+	bulkRemoveKits kitgrpc.Handler
+	restoreKit     kitgrpc.Handler
+	purgeKits      kitgrpc.Handler
+}
+
+// NewServer returns new MfxkitServiceServer instance.
+func NewServer(tracer opentracing.Tracer, svc mfxkit.Service) MfxkitServiceServer {
+	return &grpcServer{
+		ping: newServerHandler(tracer, "ping", pingEndpoint(svc),
+			decodePingRequest, encodePingResponse),
+		createKits: newServerHandler(tracer, "create_kits", createKitsEndpoint(svc),
+			decodeCreateKitsRequest, encodeKitsResponse),
+		bulkCreateKits: newServerHandler(tracer, "bulk_create_kits", bulkCreateKitsEndpoint(svc),
+			decodeBulkCreateKitsRequest, encodeBulkResponse),
+		viewKit: newServerHandler(tracer, "view_kit", viewKitEndpoint(svc),
+			decodeKitRequest, encodeKitResponse),
+		updateKit: newServerHandler(tracer, "update_kit", updateKitEndpoint(svc),
+			decodeUpdateKitRequest, encodeKitResponse),
+		listKits: newServerHandler(tracer, "list_kits", listKitsEndpoint(svc),
+			decodeListKitsRequest, encodeKitsPageResponse),
+		removeKit: newServerHandler(tracer, "remove_kit", removeKitEndpoint(svc),
+			decodeKitRequest, encodeEmptyResponse),
+		bulkRemoveKits: newServerHandler(tracer, "bulk_remove_kits", bulkRemoveKitsEndpoint(svc),
+			decodeBulkRemoveKitsRequest, encodeBulkResponse),
+		restoreKit: newServerHandler(tracer, "restore_kit", restoreKitEndpoint(svc),
+			decodeKitRequest, encodeKitResponse),
+		purgeKits: newServerHandler(tracer, "purge_kits", purgeKitsEndpoint(svc),
+			decodePurgeKitsRequest, encodePurgeResponse),
+	}
+}
+
+// newServerHandler returns the handler of the traced endpoint, whose span
+// continues the one propagated by the client.
+func newServerHandler(tracer opentracing.Tracer, operation string, e endpoint.Endpoint, dec kitgrpc.DecodeRequestFunc, enc kitgrpc.EncodeResponseFunc) kitgrpc.Handler {
+	return kitgrpc.NewServer(
+		kitot.TraceServer(tracer, operation)(e),
+		dec,
+		enc,
+		kitgrpc.ServerBefore(kitot.GRPCToContext(tracer, operation, kitlog.NewNopLogger())),
+		kitgrpc.ServerBefore(channelToContext),
+	)
+}
+
+// channelToContext records the ID of the channel the thing requests access
+// through, if any, on the request context.
+func channelToContext(ctx context.Context, md metadata.MD) context.Context {
+	if vals := md.Get(chanIDKey); len(vals) > 0 && vals[0] != "" {
+		return mfxkit.WithChannelID(ctx, vals[0])
+	}
+
+	return ctx
+}
+
+func (gs *grpcServer) Ping(ctx context.Context, req *PingReq) (*PingRes, error) {
+	_, res, err := gs.ping.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*PingRes), nil
+}
+
+func (gs *grpcServer) CreateKits(ctx context.Context, req *CreateKitsReq) (*KitsRes, error) {
+	_, res, err := gs.createKits.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*KitsRes), nil
+}
+
+func (gs *grpcServer) BulkCreateKits(ctx context.Context, req *BulkReq) (*BulkRes, error) {
+	_, res, err := gs.bulkCreateKits.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*BulkRes), nil
+}
+
+func (gs *grpcServer) ViewKit(ctx context.Context, req *KitReq) (*Kit, error) {
+	_, res, err := gs.viewKit.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*Kit), nil
+}
+
+func (gs *grpcServer) UpdateKit(ctx context.Context, req *UpdateKitReq) (*Kit, error) {
+	_, res, err := gs.updateKit.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*Kit), nil
+}
+
+func (gs *grpcServer) ListKits(ctx context.Context, req *ListKitsReq) (*KitsPage, error) {
+	_, res, err := gs.listKits.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*KitsPage), nil
+}
+
+func (gs *grpcServer) RemoveKit(ctx context.Context, req *KitReq) (*emptypb.Empty, error) {
+	_, res, err := gs.removeKit.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*emptypb.Empty), nil
+}
+
+func (gs *grpcServer) BulkRemoveKits(ctx context.Context, req *BulkReq) (*BulkRes, error) {
+	_, res, err := gs.bulkRemoveKits.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*BulkRes), nil
+}
+
+func (gs *grpcServer) RestoreKit(ctx context.Context, req *KitReq) (*Kit, error) {
+	_, res, err := gs.restoreKit.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*Kit), nil
+}
+
+func (gs *grpcServer) PurgeKits(ctx context.Context, req *PurgeKitsReq) (*PurgeRes, error) {
+	_, res, err := gs.purgeKits.ServeGRPC(ctx, req)
+	if err != nil {
+		return nil, encodeError(err)
+	}
+
+	return res.(*PurgeRes), nil
+}
+
+func decodePingRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*PingReq)
+	return pingReq{secret: req.GetSecret()}, nil
+}
+
+func decodeCreateKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*CreateKitsReq)
+	kits, err := decodeKits(req.GetKits())
+	if err != nil {
+		return nil, err
+	}
+
+	return createKitsReq{token: req.GetToken(), kits: kits}, nil
+}
+
+func decodeBulkCreateKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*BulkReq)
+	kits, err := decodeKits(req.GetKits())
+	if err != nil {
+		return nil, err
+	}
+
+	return createKitsReq{token: req.GetToken(), atomic: req.GetAtomic(), kits: kits}, nil
+}
+
+func decodeBulkRemoveKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*BulkReq)
+	kits, err := decodeKits(req.GetKits())
+	if err != nil {
+		return nil, err
+	}
+
+	return removeKitsReq{token: req.GetToken(), atomic: req.GetAtomic(), kits: kits}, nil
+}
+
+func decodeKitRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*KitReq)
+	return kitReq{token: req.GetToken(), id: req.GetId(), revision: req.GetRevision()}, nil
+}
+
+func decodeUpdateKitRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*UpdateKitReq)
+	if req.GetKit() == nil {
+		return nil, mfxkit.ErrMalformedEntity
+	}
+
+	kit, err := decodeKit(req.GetKit())
+	if err != nil {
+		return nil, err
+	}
+
+	return updateKitReq{token: req.GetToken(), kit: kit}, nil
+}
+
+func decodeListKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*ListKitsReq)
+	metadata, err := decodeMetadata(req.GetMetadata())
+	if err != nil {
+		return nil, err
+	}
+
+	pm := mfxkit.PageMetadata{
+		Offset:   req.GetOffset(),
+		Limit:    req.GetLimit(),
+		Order:    req.GetOrder(),
+		Dir:      req.GetDir(),
+		Name:     req.GetName(),
+		Metadata: metadata,
+	}
+
+	return listKitsReq{token: req.GetToken(), pageMetadata: pm}, nil
+}
+
+func decodePurgeKitsRequest(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*PurgeKitsReq)
+	return purgeKitsReq{secret: req.GetSecret()}, nil
+}
+
+func encodePingResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(pingRes)
+	return &PingRes{Greeting: res.greeting, KeyId: res.keyID}, nil
+}
+
+func encodeKitResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(kitRes)
+	return encodeKit(res.kit)
+}
+
+func encodeKitsResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(kitsRes)
+	kits, err := encodeKits(res.kits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KitsRes{Kits: kits}, nil
+}
+
+func encodeKitsPageResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(kitsPageRes)
+	kits, err := encodeKits(res.page.Kits)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KitsPage{
+		Total:  res.page.Total,
+		Offset: res.page.Offset,
+		Limit:  res.page.Limit,
+		Order:  res.page.Order,
+		Dir:    res.page.Dir,
+		Kits:   kits,
+	}, nil
+}
+
+func encodeBulkResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(bulkRes)
+	results := make([]*BulkResult, len(res.results))
+	for i, r := range res.results {
+		kit, err := encodeKit(r.Kit)
+		if err != nil {
+			return nil, err
+		}
+
+		results[i] = &BulkResult{Kit: kit}
+		if r.Err != nil {
+			results[i].Error = errorMessage(r.Err)
+		}
+	}
+
+	return &BulkRes{Results: results}, nil
+}
+
+func encodeEmptyResponse(_ context.Context, _ interface{}) (interface{}, error) {
+	return &emptypb.Empty{}, nil
+}
+
+func encodePurgeResponse(_ context.Context, grpcRes interface{}) (interface{}, error) {
+	res := grpcRes.(purgeRes)
+	return &PurgeRes{Count: res.count}, nil
+}
+
+// errorMessage returns the stable message of the bulk item error, the one
+// the client maps back to the service error, so that no internal error
+// details are sent to the client.
+func errorMessage(err error) string {
+	for _, e := range knownErrs {
+		if errors.Is(err, e) {
+			return e.Error()
+		}
+	}
+
+	return errInternal.Error()
+}
+
+func encodeError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, mfxkit.ErrMalformedEntity):
+		return status.Error(codes.InvalidArgument, mfxkit.ErrMalformedEntity.Error())
+	case errors.Is(err, mfxkit.ErrUnauthorizedAccess):
+		return status.Error(codes.Unauthenticated, mfxkit.ErrUnauthorizedAccess.Error())
+	case errors.Is(err, mfxkit.ErrForbidden):
+		return status.Error(codes.PermissionDenied, mfxkit.ErrForbidden.Error())
+	case errors.Is(err, mfxkit.ErrNotFound):
+		return status.Error(codes.NotFound, mfxkit.ErrNotFound.Error())
+	case errors.Is(err, mfxkit.ErrConflict):
+		return status.Error(codes.Aborted, mfxkit.ErrConflict.Error())
+	case errors.Is(err, mfxkit.ErrBulkAborted):
+		return status.Error(codes.FailedPrecondition, mfxkit.ErrBulkAborted.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, context.Canceled.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, context.DeadlineExceeded.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
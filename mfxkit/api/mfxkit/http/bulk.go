@@ -0,0 +1,118 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+// errInternal replaces the errors of bulk items that are not meant to be
+// exposed to the clients, such as the database driver errors.
+var errInternal = errors.New("internal error")
+
+// itemErrs are the errors of bulk items reported to the clients as they are.
+var itemErrs = []error{
+	mfxkit.ErrMalformedEntity,
+	mfxkit.ErrUnauthorizedAccess,
+	mfxkit.ErrForbidden,
+	mfxkit.ErrNotFound,
+	mfxkit.ErrConflict,
+	mfxkit.ErrBulkAborted,
+	context.Canceled,
+	context.DeadlineExceeded,
+}
+
+// bulk collects per-item outcomes of a bulk request. Items failing
+// validation are never passed to the service, and valid items keep track of
+// their position in the original request.
+type bulk struct {
+	errs    []error
+	results []mfxkit.BulkResult
+	valid   []int
+	atomic  bool
+}
+
+func newBulk(items []apiReq, atomic bool) *bulk {
+	b := &bulk{
+		errs:    make([]error, len(items)),
+		results: make([]mfxkit.BulkResult, len(items)),
+		atomic:  atomic,
+	}
+
+	for i, item := range items {
+		if err := item.validate(); err != nil {
+			b.errs[i] = err
+			continue
+		}
+		b.valid = append(b.valid, i)
+	}
+
+	return b
+}
+
+// aborted reports whether an atomic request must be rejected because some
+// of its items are invalid, in which case all the valid ones are marked as
+// aborted.
+func (b *bulk) aborted() bool {
+	if !b.atomic || len(b.valid) == len(b.errs) {
+		return false
+	}
+
+	for _, i := range b.valid {
+		b.errs[i] = mfxkit.ErrBulkAborted
+	}
+
+	return true
+}
+
+// apply maps results of the service call back to the valid items.
+func (b *bulk) apply(results []mfxkit.BulkResult) {
+	for j, res := range results {
+		i := b.valid[j]
+		b.results[i] = res
+		b.errs[i] = res.Err
+	}
+}
+
+func (b *bulk) response(success int) bulkRes {
+	res := bulkRes{
+		Results: make([]bulkItemRes, len(b.errs)),
+	}
+
+	for i, err := range b.errs {
+		item := bulkItemRes{
+			ID:     b.results[i].Kit.ID,
+			Status: success,
+		}
+
+		switch {
+		case err != nil:
+			item.Status = errorCode(err)
+			item.Error = errorMessage(err)
+		case success != http.StatusNoContent:
+			kit := toKitRes(b.results[i].Kit)
+			item.Kit = &kit
+		}
+
+		res.Results[i] = item
+	}
+
+	return res
+}
+
+// errorMessage returns the stable message of the bulk item error, so that
+// no internal error details are sent to the client.
+func errorMessage(err error) string {
+	for _, e := range itemErrs {
+		if errors.Is(err, e) {
+			return e.Error()
+		}
+	}
+
+	return errInternal.Error()
+}
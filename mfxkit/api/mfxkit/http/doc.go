@@ -3,3 +3,5 @@
 
 // Package http contains implementation of kit service HTTP API.
 package http
+
+//go:generate go run github.com/mainflux/mfxkit/cmd/httpgen -src ../../..
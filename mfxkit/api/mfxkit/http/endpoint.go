@@ -5,27 +5,223 @@ package http
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/go-kit/kit/endpoint"
 	"github.com/mainflux/mfxkit/mfxkit"
 )
 
-func pingEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+func createKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
-		req := request.(pingReq)
+		req := request.(createKitReq)
 
 		if err := req.validate(); err != nil {
 			return nil, err
 		}
 
-		greeting, err := svc.Ping(req.Secret)
+		kit := mfxkit.Kit{
+			Name:     req.Name,
+			Metadata: req.Metadata,
+		}
+		saved, err := svc.CreateKits(ctx, req.token, kit)
+		if err != nil {
+			return nil, err
+		}
+
+		res := toKitRes(saved[0])
+		res.created = true
+		return res, nil
+	}
+}
+
+func createKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(createKitsReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		items := make([]apiReq, len(req.Kits))
+		for i := range req.Kits {
+			req.Kits[i].token = req.token
+			items[i] = req.Kits[i]
+		}
+
+		bulk := newBulk(items, req.atomic)
+		if bulk.aborted() {
+			return bulk.response(http.StatusCreated), nil
+		}
+
+		kits := []mfxkit.Kit{}
+		for _, i := range bulk.valid {
+			kits = append(kits, mfxkit.Kit{
+				Name:     req.Kits[i].Name,
+				Metadata: req.Kits[i].Metadata,
+			})
+		}
+
+		results, err := svc.BulkCreateKits(ctx, req.token, req.atomic, kits...)
+		if err != nil {
+			return nil, err
+		}
+		bulk.apply(results)
+
+		return bulk.response(http.StatusCreated), nil
+	}
+}
+
+func removeKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(removeKitsReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		items := make([]apiReq, len(req.Kits))
+		for i := range req.Kits {
+			req.Kits[i].token = req.token
+			items[i] = req.Kits[i]
+		}
+
+		bulk := newBulk(items, req.atomic)
+		for i, kit := range req.Kits {
+			bulk.results[i].Kit.ID = kit.ID
+		}
+		if bulk.aborted() {
+			return bulk.response(http.StatusNoContent), nil
+		}
+
+		kits := []mfxkit.Kit{}
+		for _, i := range bulk.valid {
+			kits = append(kits, mfxkit.Kit{
+				ID:       req.Kits[i].ID,
+				Revision: req.Kits[i].Revision,
+			})
+		}
+
+		results, err := svc.BulkRemoveKits(ctx, req.token, req.atomic, kits...)
 		if err != nil {
 			return nil, err
 		}
+		bulk.apply(results)
+
+		return bulk.response(http.StatusNoContent), nil
+	}
+}
+
+func viewKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewKitReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
 
-		res := pingRes{
-			Greeting: greeting,
+		kit, err := svc.ViewKit(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
 		}
+
+		return toKitRes(kit), nil
+	}
+}
+
+func updateKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(updateKitReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		kit := mfxkit.Kit{
+			ID:       req.id,
+			Name:     req.Name,
+			Metadata: req.Metadata,
+			Revision: req.revision,
+		}
+		updated, err := svc.UpdateKit(ctx, req.token, kit)
+		if err != nil {
+			return nil, err
+		}
+
+		return toKitRes(updated), nil
+	}
+}
+
+func listKitsEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(listKitsReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		page, err := svc.ListKits(ctx, req.token, req.pageMetadata)
+		if err != nil {
+			return nil, err
+		}
+
+		res := kitsPageRes{
+			pageRes: pageRes{
+				Total:  page.Total,
+				Offset: page.Offset,
+				Limit:  page.Limit,
+				Order:  page.Order,
+				Dir:    page.Dir,
+			},
+			Kits: []kitRes{},
+		}
+		for _, kit := range page.Kits {
+			res.Kits = append(res.Kits, toKitRes(kit))
+		}
+
 		return res, nil
 	}
 }
+
+func removeKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewKitReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		if err := svc.RemoveKit(ctx, req.token, req.id, req.revision); err != nil {
+			return nil, err
+		}
+
+		return removeRes{}, nil
+	}
+}
+
+func restoreKitEndpoint(svc mfxkit.Service) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(viewKitReq)
+
+		if err := req.validate(); err != nil {
+			return nil, err
+		}
+
+		kit, err := svc.RestoreKit(ctx, req.token, req.id)
+		if err != nil {
+			return nil, err
+		}
+
+		return toKitRes(kit), nil
+	}
+}
+
+func toKitRes(kit mfxkit.Kit) kitRes {
+	return kitRes{
+		ID:        kit.ID,
+		Name:      kit.Name,
+		Metadata:  kit.Metadata,
+		Revision:  kit.Revision,
+		CreatedAt: kit.CreatedAt,
+		UpdatedAt: kit.UpdatedAt,
+	}
+}
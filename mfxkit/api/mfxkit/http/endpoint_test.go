@@ -0,0 +1,708 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/mfxkittest"
+	"github.com/mainflux/mfxkit/mfxkit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	contentType = "application/json"
+	token       = "token"
+	otherToken  = "other-token"
+)
+
+var (
+	user      = mfxkit.Identity{ID: "user", Email: "user@example.com"}
+	otherUser = mfxkit.Identity{ID: "other", Email: "other@example.com"}
+)
+
+type testRequest struct {
+	client      *http.Client
+	method      string
+	url         string
+	contentType string
+	token       string
+	headers     map[string]string
+	body        io.Reader
+}
+
+func (tr testRequest) make() (*http.Response, error) {
+	req, err := http.NewRequest(tr.method, tr.url, tr.body)
+	if err != nil {
+		return nil, err
+	}
+	if tr.token != "" {
+		req.Header.Set("Authorization", "Bearer "+tr.token)
+	}
+	if tr.contentType != "" {
+		req.Header.Set("Content-Type", tr.contentType)
+	}
+	for k, v := range tr.headers {
+		req.Header.Set(k, v)
+	}
+
+	return tr.client.Do(req)
+}
+
+// fakeAuth identifies the owners of the known tokens.
+type fakeAuth map[string]mfxkit.Identity
+
+func (fa fakeAuth) Identify(_ context.Context, token string) (mfxkit.Identity, error) {
+	id, ok := fa[token]
+	if !ok {
+		return mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess
+	}
+
+	return id, nil
+}
+
+func newServer() *mfxkittest.Server {
+	return mfxkittest.NewServer(mfxkittest.Options{
+		Auth:      fakeAuth{token: user, otherToken: otherUser},
+		Retention: time.Hour,
+	})
+}
+
+func toJSON(data interface{}) string {
+	jsonData, _ := json.Marshal(data)
+	return string(jsonData)
+}
+
+func createKit(t *testing.T, ts *mfxkittest.Server, token, name string) mfxkit.Kit {
+	return createKitWithMetadata(t, ts, token, name, nil)
+}
+
+func createKitWithMetadata(t *testing.T, ts *mfxkittest.Server, token, name string, md mfxkit.Metadata) mfxkit.Kit {
+	saved, err := ts.Service.CreateKits(context.Background(), token, mfxkit.Kit{Name: name, Metadata: md})
+	require.Nil(t, err, fmt.Sprintf("create kit %s: unexpected error: %s", name, err))
+
+	return saved[0]
+}
+
+type kitRes struct {
+	ID       string                 `json:"id"`
+	Name     string                 `json:"name"`
+	Metadata map[string]interface{} `json:"metadata"`
+	Revision uint64                 `json:"revision"`
+}
+
+type kitsPageRes struct {
+	Total  uint64   `json:"total"`
+	Offset uint64   `json:"offset"`
+	Limit  uint64   `json:"limit"`
+	Order  string   `json:"order"`
+	Dir    string   `json:"direction"`
+	Kits   []kitRes `json:"kits"`
+}
+
+type bulkItemRes struct {
+	ID     string `json:"id"`
+	Status int    `json:"status"`
+	Error  string `json:"error"`
+}
+
+type bulkRes struct {
+	Results []bulkItemRes `json:"results"`
+}
+
+func TestBulkRemoveKits(t *testing.T) {
+	cases := []struct {
+		desc     string
+		atomic   bool
+		statuses []int
+		kept     bool
+	}{
+		{
+			desc:     "remove owned, other owner's and missing kits",
+			atomic:   false,
+			statuses: []int{http.StatusNoContent, http.StatusNotFound, http.StatusNotFound},
+			kept:     false,
+		},
+		{
+			desc:     "remove owned, other owner's and missing kits atomically",
+			atomic:   true,
+			statuses: []int{http.StatusFailedDependency, http.StatusNotFound, http.StatusFailedDependency},
+			kept:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		ts := newServer()
+		owned := createKit(t, ts, token, "owned")
+		other := createKit(t, ts, otherToken, "other")
+
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodDelete,
+			url:         fmt.Sprintf("%s/kits/bulk?atomic=%t", ts.URL, tc.atomic),
+			contentType: contentType,
+			token:       token,
+			body:        strings.NewReader(toJSON([]map[string]string{{"id": owned.ID}, {"id": other.ID}, {"id": "missing"}})),
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, http.StatusMultiStatus, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, http.StatusMultiStatus, res.StatusCode))
+
+		var body bulkRes
+		err = json.NewDecoder(res.Body).Decode(&body)
+		res.Body.Close()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		require.Len(t, body.Results, len(tc.statuses), fmt.Sprintf("%s: expected %d results got %d", tc.desc, len(tc.statuses), len(body.Results)))
+		for i, item := range body.Results {
+			assert.Equal(t, tc.statuses[i], item.Status, fmt.Sprintf("%s: item %d: expected status %d got %d", tc.desc, i, tc.statuses[i], item.Status))
+		}
+
+		_, err = ts.Service.ViewKit(context.Background(), token, owned.ID)
+		assert.Equal(t, tc.kept, err == nil, fmt.Sprintf("%s: expected owned kit kept %t got error %v", tc.desc, tc.kept, err))
+		_, err = ts.Service.ViewKit(context.Background(), otherToken, other.ID)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected other owner's kit kept got error %v", tc.desc, err))
+		ts.Close()
+	}
+}
+
+func TestCreateKit(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	valid := toJSON(map[string]interface{}{"name": "kit", "metadata": map[string]interface{}{"key": "value"}})
+	longName := toJSON(map[string]string{"name": strings.Repeat("a", 1025)})
+
+	cases := []struct {
+		desc        string
+		body        string
+		contentType string
+		token       string
+		status      int
+	}{
+		{"create valid kit", valid, contentType, token, http.StatusCreated},
+		{"create kit with empty JSON", "{}", contentType, token, http.StatusCreated},
+		{"create kit with too long name", longName, contentType, token, http.StatusBadRequest},
+		{"create kit with invalid JSON", "{", contentType, token, http.StatusBadRequest},
+		{"create kit with empty request", "", contentType, token, http.StatusBadRequest},
+		{"create kit with invalid content type", valid, "text/plain", token, http.StatusUnsupportedMediaType},
+		{"create kit with invalid token", valid, contentType, "invalid", http.StatusUnauthorized},
+		{"create kit with empty token", valid, contentType, "", http.StatusUnauthorized},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/kits", ts.URL),
+			contentType: tc.contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		res.Body.Close()
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		if tc.status != http.StatusCreated {
+			continue
+		}
+		assert.Regexp(t, "^/kits/.+$", res.Header.Get("Location"), fmt.Sprintf("%s: expected kit location got %s", tc.desc, res.Header.Get("Location")))
+		assert.Equal(t, `"1"`, res.Header.Get("ETag"), fmt.Sprintf("%s: expected ETag %s got %s", tc.desc, `"1"`, res.Header.Get("ETag")))
+	}
+}
+
+func TestCreateKits(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	longName := strings.Repeat("a", 1025)
+
+	cases := []struct {
+		desc     string
+		body     string
+		atomic   bool
+		token    string
+		status   int
+		statuses []int
+	}{
+		{
+			desc:     "create valid kits",
+			body:     toJSON([]map[string]string{{"name": "kit1"}, {"name": "kit2"}}),
+			token:    token,
+			status:   http.StatusMultiStatus,
+			statuses: []int{http.StatusCreated, http.StatusCreated},
+		},
+		{
+			desc:     "create valid and invalid kits",
+			body:     toJSON([]map[string]string{{"name": "kit3"}, {"name": longName}}),
+			token:    token,
+			status:   http.StatusMultiStatus,
+			statuses: []int{http.StatusCreated, http.StatusBadRequest},
+		},
+		{
+			desc:     "create valid and invalid kits atomically",
+			body:     toJSON([]map[string]string{{"name": "kit4"}, {"name": longName}}),
+			atomic:   true,
+			token:    token,
+			status:   http.StatusMultiStatus,
+			statuses: []int{http.StatusFailedDependency, http.StatusBadRequest},
+		},
+		{
+			desc:   "create empty list of kits",
+			body:   "[]",
+			token:  token,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "create kits with invalid JSON",
+			body:   "[",
+			token:  token,
+			status: http.StatusBadRequest,
+		},
+		{
+			desc:   "create kits with invalid token",
+			body:   toJSON([]map[string]string{{"name": "kit5"}}),
+			token:  "invalid",
+			status: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/kits/bulk?atomic=%t", ts.URL, tc.atomic),
+			contentType: contentType,
+			token:       tc.token,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body bulkRes
+		if tc.status == http.StatusMultiStatus {
+			err = json.NewDecoder(res.Body).Decode(&body)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		}
+		res.Body.Close()
+
+		statuses := []int{}
+		for _, item := range body.Results {
+			statuses = append(statuses, item.Status)
+		}
+		if tc.statuses == nil {
+			tc.statuses = []int{}
+		}
+		assert.Equal(t, tc.statuses, statuses, fmt.Sprintf("%s: expected statuses %v got %v", tc.desc, tc.statuses, statuses))
+	}
+
+	page, err := ts.Service.ListKits(context.Background(), token, mfxkit.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("list kits: unexpected error: %s", err))
+	assert.Equal(t, uint64(3), page.Total, fmt.Sprintf("list kits: expected 3 created kits got %d", page.Total))
+}
+
+func TestViewKit(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	kit := createKitWithMetadata(t, ts, token, "kit", mfxkit.Metadata{"key": "value"})
+	other := createKit(t, ts, otherToken, "other")
+
+	cases := []struct {
+		desc   string
+		id     string
+		token  string
+		status int
+		res    kitRes
+	}{
+		{"view existing kit", kit.ID, token, http.StatusOK, kitRes{ID: kit.ID, Name: kit.Name, Metadata: map[string]interface{}{"key": "value"}, Revision: 1}},
+		{"view kit of other owner", other.ID, token, http.StatusNotFound, kitRes{}},
+		{"view non-existing kit", "missing", token, http.StatusNotFound, kitRes{}},
+		{"view kit with invalid token", kit.ID, "invalid", http.StatusUnauthorized, kitRes{}},
+		{"view kit with empty token", kit.ID, "", http.StatusUnauthorized, kitRes{}},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/kits/%s", ts.URL, tc.id),
+			token:  tc.token,
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body kitRes
+		if tc.status == http.StatusOK {
+			err = json.NewDecoder(res.Body).Decode(&body)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+			assert.Equal(t, `"1"`, res.Header.Get("ETag"), fmt.Sprintf("%s: expected ETag %s got %s", tc.desc, `"1"`, res.Header.Get("ETag")))
+		}
+		res.Body.Close()
+		assert.Equal(t, tc.res, body, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, body))
+	}
+}
+
+func TestUpdateKit(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	kit := createKit(t, ts, token, "kit")
+	other := createKit(t, ts, otherToken, "other")
+	body := toJSON(map[string]string{"name": "renamed"})
+
+	cases := []struct {
+		desc        string
+		id          string
+		ifMatch     string
+		body        string
+		contentType string
+		token       string
+		status      int
+		etag        string
+	}{
+		{"update kit with matching revision", kit.ID, `"1"`, body, contentType, token, http.StatusOK, `"2"`},
+		{"update kit with stale revision", kit.ID, `"1"`, body, contentType, token, http.StatusConflict, ""},
+		{"update kit with any revision", kit.ID, "*", body, contentType, token, http.StatusOK, `"3"`},
+		{"update kit without revision", kit.ID, "", body, contentType, token, http.StatusOK, `"4"`},
+		{"update kit with invalid revision", kit.ID, "invalid", body, contentType, token, http.StatusBadRequest, ""},
+		{"update kit with zero revision", kit.ID, `"0"`, body, contentType, token, http.StatusBadRequest, ""},
+		{"update kit of other owner", other.ID, "", body, contentType, token, http.StatusNotFound, ""},
+		{"update non-existing kit", "missing", "", body, contentType, token, http.StatusNotFound, ""},
+		{"update kit with too long name", kit.ID, "", toJSON(map[string]string{"name": strings.Repeat("a", 1025)}), contentType, token, http.StatusBadRequest, ""},
+		{"update kit with invalid JSON", kit.ID, "", "{", contentType, token, http.StatusBadRequest, ""},
+		{"update kit with invalid content type", kit.ID, "", body, "text/plain", token, http.StatusUnsupportedMediaType, ""},
+		{"update kit with invalid token", kit.ID, "", body, contentType, "invalid", http.StatusUnauthorized, ""},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPut,
+			url:         fmt.Sprintf("%s/kits/%s", ts.URL, tc.id),
+			contentType: tc.contentType,
+			token:       tc.token,
+			headers:     map[string]string{"If-Match": tc.ifMatch},
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		res.Body.Close()
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+		assert.Equal(t, tc.etag, res.Header.Get("ETag"), fmt.Sprintf("%s: expected ETag %s got %s", tc.desc, tc.etag, res.Header.Get("ETag")))
+	}
+}
+
+func TestRemoveAndRestoreKit(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	kit := createKit(t, ts, token, "kit")
+	other := createKit(t, ts, otherToken, "other")
+
+	cases := []struct {
+		desc    string
+		method  string
+		path    string
+		ifMatch string
+		token   string
+		status  int
+	}{
+		{"restore kit that is not removed", http.MethodPost, fmt.Sprintf("/kits/%s/restore", kit.ID), "", token, http.StatusNotFound},
+		{"remove kit with stale revision", http.MethodDelete, fmt.Sprintf("/kits/%s", kit.ID), `"2"`, token, http.StatusConflict},
+		{"remove kit with invalid revision", http.MethodDelete, fmt.Sprintf("/kits/%s", kit.ID), "invalid", token, http.StatusBadRequest},
+		{"remove kit of other owner", http.MethodDelete, fmt.Sprintf("/kits/%s", other.ID), "", token, http.StatusNotFound},
+		{"remove kit with invalid token", http.MethodDelete, fmt.Sprintf("/kits/%s", kit.ID), "", "invalid", http.StatusUnauthorized},
+		{"remove kit with matching revision", http.MethodDelete, fmt.Sprintf("/kits/%s", kit.ID), `"1"`, token, http.StatusNoContent},
+		{"view removed kit", http.MethodGet, fmt.Sprintf("/kits/%s", kit.ID), "", token, http.StatusNotFound},
+		{"remove removed kit", http.MethodDelete, fmt.Sprintf("/kits/%s", kit.ID), "", token, http.StatusNotFound},
+		{"restore removed kit with invalid token", http.MethodPost, fmt.Sprintf("/kits/%s/restore", kit.ID), "", "invalid", http.StatusUnauthorized},
+		{"restore removed kit", http.MethodPost, fmt.Sprintf("/kits/%s/restore", kit.ID), "", token, http.StatusOK},
+		{"view restored kit", http.MethodGet, fmt.Sprintf("/kits/%s", kit.ID), "", token, http.StatusOK},
+		{"restore non-existing kit", http.MethodPost, "/kits/missing/restore", "", token, http.StatusNotFound},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:  ts.Client(),
+			method:  tc.method,
+			url:     ts.URL + tc.path,
+			token:   tc.token,
+			headers: map[string]string{"If-Match": tc.ifMatch},
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		res.Body.Close()
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestListKits(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	names := []string{"alpha", "beta", "gamma", "delta", "epsilon"}
+	for i, name := range names {
+		createKitWithMetadata(t, ts, token, name, mfxkit.Metadata{"even": i%2 == 0})
+	}
+	createKit(t, ts, otherToken, "alpha")
+
+	cases := []struct {
+		desc   string
+		query  string
+		token  string
+		status int
+		total  uint64
+		names  []string
+	}{
+		{"list kits with default page", "", token, http.StatusOK, 5, []string{"alpha", "beta", "gamma", "delta", "epsilon"}},
+		{"list kits with offset and limit", "?offset=1&limit=2", token, http.StatusOK, 5, []string{"beta", "gamma"}},
+		{"list kits with offset past the last kit", "?offset=10", token, http.StatusOK, 5, []string{}},
+		{"list kits ordered by name", "?order=name&dir=desc&limit=3", token, http.StatusOK, 5, []string{"gamma", "epsilon", "delta"}},
+		{"list kits filtered by name", "?name=ALP", token, http.StatusOK, 1, []string{"alpha"}},
+		{"list kits filtered by metadata", "?metadata=" + url.QueryEscape(`{"even":true}`), token, http.StatusOK, 3, []string{"alpha", "gamma", "epsilon"}},
+		{"list kits filtered by name and metadata", "?name=a&metadata=" + url.QueryEscape(`{"even":false}`), token, http.StatusOK, 2, []string{"beta", "delta"}},
+		{"list kits with too large limit", "?limit=101", token, http.StatusBadRequest, 0, nil},
+		{"list kits with invalid offset", "?offset=invalid", token, http.StatusBadRequest, 0, nil},
+		{"list kits with duplicate limit", "?limit=1&limit=2", token, http.StatusBadRequest, 0, nil},
+		{"list kits with invalid order", "?order=invalid", token, http.StatusBadRequest, 0, nil},
+		{"list kits with invalid direction", "?dir=invalid", token, http.StatusBadRequest, 0, nil},
+		{"list kits with invalid metadata", "?metadata=invalid", token, http.StatusBadRequest, 0, nil},
+		{"list kits with invalid token", "", "invalid", http.StatusUnauthorized, 0, nil},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/kits%s", ts.URL, tc.query),
+			token:  tc.token,
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+
+		var body kitsPageRes
+		if tc.status == http.StatusOK {
+			err = json.NewDecoder(res.Body).Decode(&body)
+			require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		}
+		res.Body.Close()
+
+		var names []string
+		if body.Kits != nil {
+			names = []string{}
+		}
+		for _, kit := range body.Kits {
+			names = append(names, kit.Name)
+		}
+		assert.Equal(t, tc.total, body.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, tc.total, body.Total))
+		assert.Equal(t, tc.names, names, fmt.Sprintf("%s: expected kits %v got %v", tc.desc, tc.names, names))
+	}
+}
+
+func newMockServer() (*mfxkittest.Server, *mocks.Service) {
+	svc := mocks.NewService(map[string]string{token: user.ID})
+	return mfxkittest.NewServer(mfxkittest.Options{Service: svc}), svc
+}
+
+func TestServiceErrors(t *testing.T) {
+	ts, svc := newMockServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		err    error
+		status int
+	}{
+		{"view kit failing with malformed entity", mfxkit.ErrMalformedEntity, http.StatusBadRequest},
+		{"view kit failing with unauthorized access", mfxkit.ErrUnauthorizedAccess, http.StatusUnauthorized},
+		{"view kit failing with forbidden access", mfxkit.ErrForbidden, http.StatusForbidden},
+		{"view kit failing with not found", mfxkit.ErrNotFound, http.StatusNotFound},
+		{"view kit failing with conflict", mfxkit.ErrConflict, http.StatusConflict},
+		{"view kit failing with wrapped not found", fmt.Errorf("retrieve kit: %w", mfxkit.ErrNotFound), http.StatusNotFound},
+		{"view kit failing with canceled context", context.Canceled, 499},
+		{"view kit failing with exceeded deadline", context.DeadlineExceeded, http.StatusGatewayTimeout},
+		{"view kit failing with unknown error", errors.New("database error"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		svc.Reset()
+		svc.Script("ViewKit", mocks.Behaviour{Err: tc.err})
+
+		req := testRequest{
+			client: ts.Client(),
+			method: http.MethodGet,
+			url:    fmt.Sprintf("%s/kits/id", ts.URL),
+			token:  token,
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		res.Body.Close()
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
+
+func TestBulkItemErrors(t *testing.T) {
+	ts, svc := newMockServer()
+	defer ts.Close()
+
+	svc.Script("BulkCreateKits", mocks.Behaviour{Result: []mfxkit.BulkResult{
+		{Kit: mfxkit.Kit{ID: "created", Revision: 1}},
+		{Err: fmt.Errorf("save kit: %w", mfxkit.ErrConflict)},
+		{Err: errors.New("pq: connection refused")},
+	}})
+
+	req := testRequest{
+		client:      ts.Client(),
+		method:      http.MethodPost,
+		url:         fmt.Sprintf("%s/kits/bulk", ts.URL),
+		contentType: contentType,
+		token:       token,
+		body:        strings.NewReader(toJSON([]map[string]string{{"name": "kit1"}, {"name": "kit2"}, {"name": "kit3"}})),
+	}
+	res, err := req.make()
+	require.Nil(t, err, fmt.Sprintf("create kits: unexpected error: %s", err))
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusMultiStatus, res.StatusCode, fmt.Sprintf("create kits: expected status code %d got %d", http.StatusMultiStatus, res.StatusCode))
+
+	var body bulkRes
+	err = json.NewDecoder(res.Body).Decode(&body)
+	require.Nil(t, err, fmt.Sprintf("create kits: unexpected error: %s", err))
+
+	expected := []bulkItemRes{
+		{ID: "created", Status: http.StatusCreated},
+		{Status: http.StatusConflict, Error: mfxkit.ErrConflict.Error()},
+		{Status: http.StatusInternalServerError, Error: "internal error"},
+	}
+	assert.Equal(t, expected, body.Results, fmt.Sprintf("create kits: expected %v got %v", expected, body.Results))
+}
+
+func TestRequestDecoding(t *testing.T) {
+	ts, svc := newMockServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc    string
+		method  string
+		path    string
+		headers map[string]string
+		body    string
+		call    mocks.Call
+	}{
+		{
+			desc:    "update kit with revision",
+			method:  http.MethodPut,
+			path:    "/kits/id",
+			headers: map[string]string{"If-Match": `"3"`},
+			body:    toJSON(map[string]interface{}{"name": "kit", "metadata": map[string]interface{}{"key": "value"}}),
+			call: mocks.Call{
+				Method: "UpdateKit",
+				Args:   []interface{}{token, mfxkit.Kit{ID: "id", Name: "kit", Metadata: mfxkit.Metadata{"key": "value"}, Revision: 3}},
+			},
+		},
+		{
+			desc:    "remove kit with revision",
+			method:  http.MethodDelete,
+			path:    "/kits/id",
+			headers: map[string]string{"If-Match": `"2"`},
+			call:    mocks.Call{Method: "RemoveKit", Args: []interface{}{token, "id", uint64(2)}},
+		},
+		{
+			desc:   "list kits with page metadata",
+			method: http.MethodGet,
+			path:   "/kits?offset=5&limit=20&order=name&dir=desc&name=kit&metadata=" + url.QueryEscape(`{"key":"value"}`),
+			call: mocks.Call{
+				Method: "ListKits",
+				Args: []interface{}{token, mfxkit.PageMetadata{
+					Offset:   5,
+					Limit:    20,
+					Order:    mfxkit.OrderByName,
+					Dir:      mfxkit.DescDir,
+					Name:     "kit",
+					Metadata: mfxkit.Metadata{"key": "value"},
+				}},
+			},
+		},
+		{
+			desc:   "list kits with default page metadata",
+			method: http.MethodGet,
+			path:   "/kits",
+			call:   mocks.Call{Method: "ListKits", Args: []interface{}{token, mfxkit.PageMetadata{Limit: mfxkit.DefLimit}}},
+		},
+		{
+			desc:   "remove kits atomically",
+			method: http.MethodDelete,
+			path:   "/kits/bulk?atomic=true",
+			body:   toJSON([]map[string]interface{}{{"id": "id1", "revision": 1}, {"id": "id2"}}),
+			call: mocks.Call{
+				Method: "BulkRemoveKits",
+				Args:   []interface{}{token, true, []mfxkit.Kit{{ID: "id1", Revision: 1}, {ID: "id2"}}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		svc.Reset()
+
+		req := testRequest{
+			client:      ts.Client(),
+			method:      tc.method,
+			url:         ts.URL + tc.path,
+			contentType: contentType,
+			token:       token,
+			headers:     tc.headers,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		res.Body.Close()
+
+		calls := svc.CallsTo(tc.call.Method)
+		require.Len(t, calls, 1, fmt.Sprintf("%s: expected a single call to %s got %d", tc.desc, tc.call.Method, len(calls)))
+		assert.Equal(t, tc.call, calls[0], fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.call, calls[0]))
+	}
+}
+
+func TestPing(t *testing.T) {
+	ts := newServer()
+	defer ts.Close()
+
+	cases := []struct {
+		desc   string
+		body   string
+		status int
+	}{
+		{"ping with valid secret", toJSON(map[string]string{"secret": mfxkittest.DefSecret}), http.StatusOK},
+		{"ping with invalid secret", toJSON(map[string]string{"secret": "invalid"}), http.StatusForbidden},
+		{"ping with empty secret", "{}", http.StatusBadRequest},
+		{"ping with invalid JSON", "{", http.StatusBadRequest},
+	}
+
+	for _, tc := range cases {
+		req := testRequest{
+			client:      ts.Client(),
+			method:      http.MethodPost,
+			url:         fmt.Sprintf("%s/mfxkit", ts.URL),
+			contentType: contentType,
+			body:        strings.NewReader(tc.body),
+		}
+		res, err := req.make()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		res.Body.Close()
+		assert.Equal(t, tc.status, res.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, res.StatusCode))
+	}
+}
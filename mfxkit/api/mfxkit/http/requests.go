@@ -5,18 +5,142 @@ package http
 
 import "github.com/mainflux/mfxkit/mfxkit"
 
+const (
+	maxNameSize = 1024
+	maxBulkSize = 1000
+)
+
 type apiReq interface {
 	validate() error
 }
 
-type pingReq struct {
-	Secret string `json:"secret"`
+type createKitReq struct {
+	token    string
+	Name     string                 `json:"name,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req createKitReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if len(req.Name) > maxNameSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
 }
 
-func (req pingReq) validate() error {
-	if req.Secret == "" {
+type createKitsReq struct {
+	token  string
+	atomic bool
+	Kits   []createKitReq
+}
+
+func (req createKitsReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if len(req.Kits) == 0 || len(req.Kits) > maxBulkSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type removeKitReq struct {
+	token    string
+	ID       string `json:"id"`
+	Revision uint64 `json:"revision,omitempty"`
+}
+
+func (req removeKitReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if req.ID == "" {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type removeKitsReq struct {
+	token  string
+	atomic bool
+	Kits   []removeKitReq
+}
+
+func (req removeKitsReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if len(req.Kits) == 0 || len(req.Kits) > maxBulkSize {
 		return mfxkit.ErrMalformedEntity
 	}
 
 	return nil
 }
+
+type updateKitReq struct {
+	token    string
+	id       string
+	revision uint64
+	Name     string                 `json:"name,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+func (req updateKitReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if req.id == "" {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	if len(req.Name) > maxNameSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type viewKitReq struct {
+	token    string
+	id       string
+	revision uint64
+}
+
+func (req viewKitReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if req.id == "" {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return nil
+}
+
+type listKitsReq struct {
+	token        string
+	pageMetadata mfxkit.PageMetadata
+}
+
+func (req listKitsReq) validate() error {
+	if req.token == "" {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	if len(req.pageMetadata.Name) > maxNameSize {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return req.pageMetadata.Validate()
+}
@@ -4,25 +4,112 @@
 package http
 
 import (
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/mainflux/mainflux"
 )
 
-var _ mainflux.Response = (*pingRes)(nil)
+var (
+	_ mainflux.Response = (*kitRes)(nil)
+	_ mainflux.Response = (*kitsPageRes)(nil)
+	_ mainflux.Response = (*removeRes)(nil)
+	_ mainflux.Response = (*bulkRes)(nil)
+)
+
+type kitRes struct {
+	ID        string                 `json:"id"`
+	Name      string                 `json:"name,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	Revision  uint64                 `json:"revision"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+	created   bool
+}
+
+func (res kitRes) Code() int {
+	if res.created {
+		return http.StatusCreated
+	}
+
+	return http.StatusOK
+}
+
+func (res kitRes) Headers() map[string]string {
+	headers := map[string]string{
+		"ETag": formatETag(res.Revision),
+	}
+
+	if res.created {
+		headers["Location"] = fmt.Sprintf("/kits/%s", res.ID)
+	}
 
-type pingRes struct {
-	Greeting string `json:"greeting"`
+	return headers
 }
 
-func (res pingRes) Code() int {
+func (res kitRes) Empty() bool {
+	return false
+}
+
+type kitsPageRes struct {
+	pageRes
+	Kits []kitRes `json:"kits"`
+}
+
+func (res kitsPageRes) Code() int {
 	return http.StatusOK
 }
 
-func (res pingRes) Headers() map[string]string {
+func (res kitsPageRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res kitsPageRes) Empty() bool {
+	return false
+}
+
+type removeRes struct{}
+
+func (res removeRes) Code() int {
+	return http.StatusNoContent
+}
+
+func (res removeRes) Headers() map[string]string {
+	return map[string]string{}
+}
+
+func (res removeRes) Empty() bool {
+	return true
+}
+
+type pageRes struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+	Order  string `json:"order"`
+	Dir    string `json:"direction"`
+}
+
+type bulkItemRes struct {
+	ID     string  `json:"id,omitempty"`
+	Status int     `json:"status"`
+	Error  string  `json:"error,omitempty"`
+	Kit    *kitRes `json:"kit,omitempty"`
+}
+
+type bulkRes struct {
+	Results []bulkItemRes `json:"results"`
+}
+
+func (res bulkRes) Code() int {
+	return http.StatusMultiStatus
+}
+
+func (res bulkRes) Headers() map[string]string {
 	return map[string]string{}
 }
 
-func (res pingRes) Empty() bool {
+func (res bulkRes) Empty() bool {
 	return false
 }
@@ -0,0 +1,30 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/mainflux/mfxkit/pkg/httpgen"
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedRoutes fails if the committed routes differ from the ones
+// generated from the annotations of the current service interface. Run go
+// generate to update them.
+func TestGeneratedRoutes(t *testing.T) {
+	svc, err := mwgen.Parse("../../..", "Service")
+	require.Nil(t, err, fmt.Sprintf("parse service: unexpected error: %s", err))
+
+	src, err := httpgen.Generate(svc, httpgen.Config{Package: "http"})
+	require.Nil(t, err, fmt.Sprintf("generate routes: unexpected error: %s", err))
+
+	committed, err := ioutil.ReadFile("routes.go")
+	require.Nil(t, err, fmt.Sprintf("read routes.go: unexpected error: %s", err))
+	assert.Equal(t, string(src), string(committed), "routes.go is stale, run go generate")
+}
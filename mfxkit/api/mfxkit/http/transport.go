@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
@@ -18,45 +19,184 @@ import (
 	"github.com/mainflux/mainflux"
 	"github.com/mainflux/mfxkit/mfxkit"
 	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 const (
 	contentType = "application/json"
+	offsetKey   = "offset"
+	limitKey    = "limit"
+	orderKey    = "order"
+	dirKey      = "dir"
+	nameKey     = "name"
+	metadataKey = "metadata"
+	atomicKey   = "atomic"
+	bearer      = "Bearer "
+	chanIDKey   = "Channel-ID"
+	defOffset   = 0
+	defLimit    = mfxkit.DefLimit
+
+	// statusClientClosedRequest is the non-standard status of the requests
+	// the client has cancelled before the response was sent.
+	statusClientClosedRequest = 499
 )
 
 var (
 	errUnsupportedContentType = errors.New("unsupported content type")
 	errInvalidQueryParams     = errors.New("invalid query params")
+	errInvalidMetadataQuery   = errors.New("invalid metadata query")
+	errInvalidIfMatch         = errors.New("invalid If-Match header")
 )
 
-// MakeHandler returns a HTTP handler for API endpoints.
-func MakeHandler(tracer opentracing.Tracer, svc mfxkit.Service) http.Handler {
+// MakeHandler returns a HTTP handler for API endpoints. The metrics endpoint
+// exposes the metrics collected by the given gatherer.
+func MakeHandler(tracer opentracing.Tracer, svc mfxkit.Service, gatherer prometheus.Gatherer) http.Handler {
 	opts := []kithttp.ServerOption{
+		kithttp.ServerBefore(channelToContext),
 		kithttp.ServerErrorEncoder(encodeError),
 	}
 
 	r := bone.New()
 
-	r.Post("/mfxkit", kithttp.NewServer(
-		kitot.TraceServer(tracer, "ping")(pingEndpoint(svc)),
-		decodePing,
+	// Ping keeps responding with 403 to invalid secrets, as it did before
+	// the other routes started responding with 401 to invalid credentials.
+	registerRoutes(r, tracer, svc, append(opts, kithttp.ServerErrorEncoder(encodePingError))...)
+
+	// Kit routes are hand-written, since httpgen does not support the
+	// If-Match and ETag revisions, the metadata query or bulk responses.
+	r.Post("/kits", kithttp.NewServer(
+		kitot.TraceServer(tracer, "create_kit")(createKitEndpoint(svc)),
+		decodeKitCreation,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Post("/kits/bulk", kithttp.NewServer(
+		kitot.TraceServer(tracer, "bulk_create_kits")(createKitsEndpoint(svc)),
+		decodeKitsCreation,
 		encodeResponse,
 		opts...,
 	))
 
-	r.GetFunc("/version", mainflux.Version("things"))
-	r.Handle("/metrics", promhttp.Handler())
+	r.Delete("/kits/bulk", kithttp.NewServer(
+		kitot.TraceServer(tracer, "bulk_remove_kits")(removeKitsEndpoint(svc)),
+		decodeKitsRemoval,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/kits/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "view_kit")(viewKitEndpoint(svc)),
+		decodeView,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Put("/kits/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "update_kit")(updateKitEndpoint(svc)),
+		decodeKitUpdate,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Delete("/kits/:id", kithttp.NewServer(
+		kitot.TraceServer(tracer, "remove_kit")(removeKitEndpoint(svc)),
+		decodeRemove,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Post("/kits/:id/restore", kithttp.NewServer(
+		kitot.TraceServer(tracer, "restore_kit")(restoreKitEndpoint(svc)),
+		decodeView,
+		encodeResponse,
+		opts...,
+	))
+
+	r.Get("/kits", kithttp.NewServer(
+		kitot.TraceServer(tracer, "list_kits")(listKitsEndpoint(svc)),
+		decodeList,
+		encodeResponse,
+		opts...,
+	))
+
+	r.GetFunc("/version", mainflux.Version("mfxkit"))
+	r.Handle("/metrics", promhttp.HandlerFor(gatherer, promhttp.HandlerOpts{}))
 
 	return r
 }
 
-func decodePing(_ context.Context, r *http.Request) (interface{}, error) {
+func decodeKitCreation(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, errUnsupportedContentType
+	}
+
+	req := createKitReq{token: authToken(r)}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func decodeKitsCreation(_ context.Context, r *http.Request) (interface{}, error) {
 	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
 		return nil, errUnsupportedContentType
 	}
 
-	req := pingReq{}
+	a, err := readBoolQuery(r, atomicKey, false)
+	if err != nil {
+		return nil, err
+	}
+
+	req := createKitsReq{
+		token:  authToken(r),
+		atomic: a,
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.Kits); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func decodeKitsRemoval(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, errUnsupportedContentType
+	}
+
+	a, err := readBoolQuery(r, atomicKey, false)
+	if err != nil {
+		return nil, err
+	}
+
+	req := removeKitsReq{
+		token:  authToken(r),
+		atomic: a,
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req.Kits); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func decodeKitUpdate(_ context.Context, r *http.Request) (interface{}, error) {
+	if !strings.Contains(r.Header.Get("Content-Type"), contentType) {
+		return nil, errUnsupportedContentType
+	}
+
+	rev, err := readIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateKitReq{
+		token:    authToken(r),
+		id:       bone.GetValue(r, "id"),
+		revision: rev,
+	}
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		return nil, err
 	}
@@ -64,6 +204,76 @@ func decodePing(_ context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func decodeRemove(_ context.Context, r *http.Request) (interface{}, error) {
+	rev, err := readIfMatch(r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := viewKitReq{
+		token:    authToken(r),
+		id:       bone.GetValue(r, "id"),
+		revision: rev,
+	}
+
+	return req, nil
+}
+
+func decodeView(_ context.Context, r *http.Request) (interface{}, error) {
+	req := viewKitReq{
+		token: authToken(r),
+		id:    bone.GetValue(r, "id"),
+	}
+
+	return req, nil
+}
+
+func decodeList(_ context.Context, r *http.Request) (interface{}, error) {
+	o, err := readUintQuery(r, offsetKey, defOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := readUintQuery(r, limitKey, defLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	or, err := readStringQuery(r, orderKey)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := readStringQuery(r, dirKey)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err := readStringQuery(r, nameKey)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := readMetadataQuery(r, metadataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	req := listKitsReq{
+		token: authToken(r),
+		pageMetadata: mfxkit.PageMetadata{
+			Offset:   o,
+			Limit:    l,
+			Order:    or,
+			Dir:      d,
+			Name:     n,
+			Metadata: m,
+		},
+	}
+
+	return req, nil
+}
+
 func encodeResponse(_ context.Context, w http.ResponseWriter, response interface{}) error {
 	w.Header().Set("Content-Type", contentType)
 
@@ -84,32 +294,76 @@ func encodeResponse(_ context.Context, w http.ResponseWriter, response interface
 
 func encodeError(_ context.Context, err error, w http.ResponseWriter) {
 	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(errorCode(err))
+}
 
-	switch err {
-	case mfxkit.ErrMalformedEntity:
-		w.WriteHeader(http.StatusBadRequest)
-	case mfxkit.ErrUnauthorizedAccess:
+// encodePingError encodes the errors of the ping route, which rejects
+// invalid secrets with 403.
+func encodePingError(ctx context.Context, err error, w http.ResponseWriter) {
+	if errors.Is(err, mfxkit.ErrUnauthorizedAccess) {
+		w.Header().Set("Content-Type", contentType)
 		w.WriteHeader(http.StatusForbidden)
-	case errUnsupportedContentType:
-		w.WriteHeader(http.StatusUnsupportedMediaType)
-	case errInvalidQueryParams:
-		w.WriteHeader(http.StatusBadRequest)
-	case io.ErrUnexpectedEOF:
-		w.WriteHeader(http.StatusBadRequest)
-	case io.EOF:
-		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	encodeError(ctx, err, w)
+}
+
+func errorCode(err error) int {
+	var (
+		syntaxErr *json.SyntaxError
+		typeErr   *json.UnmarshalTypeError
+	)
+
+	switch {
+	case errors.Is(err, mfxkit.ErrMalformedEntity):
+		return http.StatusBadRequest
+	case errors.Is(err, mfxkit.ErrUnauthorizedAccess):
+		return http.StatusUnauthorized
+	case errors.Is(err, mfxkit.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, mfxkit.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, mfxkit.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, mfxkit.ErrBulkAborted):
+		return http.StatusFailedDependency
+	case errors.Is(err, context.Canceled):
+		return statusClientClosedRequest
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	case errors.Is(err, errUnsupportedContentType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, errInvalidQueryParams),
+		errors.Is(err, errInvalidMetadataQuery),
+		errors.Is(err, errInvalidIfMatch),
+		errors.Is(err, io.ErrUnexpectedEOF),
+		errors.Is(err, io.EOF),
+		errors.As(err, &syntaxErr),
+		errors.As(err, &typeErr):
+		return http.StatusBadRequest
 	default:
-		switch err.(type) {
-		case *json.SyntaxError:
-			w.WriteHeader(http.StatusBadRequest)
-		case *json.UnmarshalTypeError:
-			w.WriteHeader(http.StatusBadRequest)
-		default:
-			w.WriteHeader(http.StatusInternalServerError)
-		}
+		return http.StatusInternalServerError
 	}
 }
 
+// authToken returns the token of the Authorization header. The Bearer scheme
+// is optional, so that both user tokens and the service secret can be sent
+// as they are.
+func authToken(r *http.Request) string {
+	return strings.TrimPrefix(r.Header.Get("Authorization"), bearer)
+}
+
+// channelToContext records the ID of the channel the thing requests access
+// through, if any, on the request context.
+func channelToContext(ctx context.Context, r *http.Request) context.Context {
+	if chanID := r.Header.Get(chanIDKey); chanID != "" {
+		return mfxkit.WithChannelID(ctx, chanID)
+	}
+
+	return ctx
+}
+
 func readUintQuery(r *http.Request, key string, def uint64) (uint64, error) {
 	vals := bone.GetQuery(r, key)
 	if len(vals) > 1 {
@@ -129,6 +383,24 @@ func readUintQuery(r *http.Request, key string, def uint64) (uint64, error) {
 	return val, nil
 }
 
+func readBoolQuery(r *http.Request, key string, def bool) (bool, error) {
+	vals := bone.GetQuery(r, key)
+	if len(vals) > 1 {
+		return false, errInvalidQueryParams
+	}
+
+	if len(vals) == 0 {
+		return def, nil
+	}
+
+	b, err := strconv.ParseBool(vals[0])
+	if err != nil {
+		return false, errInvalidQueryParams
+	}
+
+	return b, nil
+}
+
 func readStringQuery(r *http.Request, key string) (string, error) {
 	vals := bone.GetQuery(r, key)
 	if len(vals) > 1 {
@@ -141,3 +413,41 @@ func readStringQuery(r *http.Request, key string) (string, error) {
 
 	return vals[0], nil
 }
+
+func readMetadataQuery(r *http.Request, key string) (mfxkit.Metadata, error) {
+	vals := bone.GetQuery(r, key)
+	if len(vals) > 1 {
+		return nil, errInvalidQueryParams
+	}
+
+	if len(vals) == 0 {
+		return nil, nil
+	}
+
+	m := mfxkit.Metadata{}
+	if err := json.Unmarshal([]byte(vals[0]), &m); err != nil {
+		return nil, errInvalidMetadataQuery
+	}
+
+	return m, nil
+}
+
+// readIfMatch returns the kit revision from If-Match header. Missing header
+// or "*" yield zero revision, which skips the revision check.
+func readIfMatch(r *http.Request) (uint64, error) {
+	val := strings.TrimSpace(r.Header.Get("If-Match"))
+	if val == "" || val == "*" {
+		return 0, nil
+	}
+
+	rev, err := strconv.ParseUint(strings.Trim(val, `"`), 10, 64)
+	if err != nil || rev == 0 {
+		return 0, errInvalidIfMatch
+	}
+
+	return rev, nil
+}
+
+func formatETag(rev uint64) string {
+	return fmt.Sprintf(`"%d"`, rev)
+}
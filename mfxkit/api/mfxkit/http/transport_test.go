@@ -0,0 +1,62 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCode(t *testing.T) {
+	cases := []struct {
+		desc string
+		err  error
+		code int
+	}{
+		{"malformed entity", mfxkit.ErrMalformedEntity, http.StatusBadRequest},
+		{"unauthorized access", mfxkit.ErrUnauthorizedAccess, http.StatusUnauthorized},
+		{"forbidden", mfxkit.ErrForbidden, http.StatusForbidden},
+		{"not found", mfxkit.ErrNotFound, http.StatusNotFound},
+		{"wrapped not found", fmt.Errorf("retrieve kit: %w", mfxkit.ErrNotFound), http.StatusNotFound},
+		{"conflict item", &mfxkit.ItemError{Index: 1, Err: mfxkit.ErrConflict}, http.StatusConflict},
+		{"bulk aborted", mfxkit.ErrBulkAborted, http.StatusFailedDependency},
+		{"canceled", context.Canceled, statusClientClosedRequest},
+		{"wrapped deadline exceeded", fmt.Errorf("save kits: %w", context.DeadlineExceeded), http.StatusGatewayTimeout},
+		{"unsupported content type", errUnsupportedContentType, http.StatusUnsupportedMediaType},
+		{"invalid If-Match", errInvalidIfMatch, http.StatusBadRequest},
+		{"empty body", io.EOF, http.StatusBadRequest},
+		{"unknown", errors.New("connection refused"), http.StatusInternalServerError},
+	}
+
+	for _, tc := range cases {
+		code := errorCode(tc.err)
+		assert.Equal(t, tc.code, code, fmt.Sprintf("%s: expected %d got %d", tc.desc, tc.code, code))
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	cases := []struct {
+		desc string
+		err  error
+		msg  string
+	}{
+		{"not found", mfxkit.ErrNotFound, mfxkit.ErrNotFound.Error()},
+		{"conflict item", &mfxkit.ItemError{Index: 1, Err: mfxkit.ErrConflict}, mfxkit.ErrConflict.Error()},
+		{"bulk aborted", mfxkit.ErrBulkAborted, mfxkit.ErrBulkAborted.Error()},
+		{"deadline exceeded", context.DeadlineExceeded, context.DeadlineExceeded.Error()},
+		{"driver error", errors.New(`pq: duplicate key value violates unique constraint "kits_pkey"`), errInternal.Error()},
+	}
+
+	for _, tc := range cases {
+		msg := errorMessage(tc.err)
+		assert.Equal(t, tc.msg, msg, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.msg, msg))
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package api
+
+import (
+	"github.com/go-kit/kit/metrics"
+	kitprometheus "github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// MakeMetrics creates the request counter, latency and item counter used by
+// the metrics middleware and registers them with the given registerer.
+// Requests are counted by the ID of the secret that identified the caller
+// too, which is empty for unauthenticated requests.
+func MakeMetrics(reg stdprometheus.Registerer) (metrics.Counter, metrics.Histogram, metrics.Counter) {
+	counter := stdprometheus.NewCounterVec(stdprometheus.CounterOpts{
+		Namespace: "mfxkit",
+		Subsystem: "api",
+		Name:      "request_count",
+		Help:      "Number of requests received.",
+	}, []string{"method", "key_id"})
+	latency := stdprometheus.NewSummaryVec(stdprometheus.SummaryOpts{
+		Namespace: "mfxkit",
+		Subsystem: "api",
+		Name:      "request_latency_microseconds",
+		Help:      "Total duration of requests in microseconds.",
+	}, []string{"method"})
+	items := stdprometheus.NewCounterVec(stdprometheus.CounterOpts{
+		Namespace: "mfxkit",
+		Subsystem: "api",
+		Name:      "item_count",
+		Help:      "Number of kits processed by requests.",
+	}, []string{"method"})
+	reg.MustRegister(counter, latency, items)
+
+	return kitprometheus.NewCounter(counter), kitprometheus.NewSummary(latency), kitprometheus.NewCounter(items)
+}
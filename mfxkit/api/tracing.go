@@ -0,0 +1,166 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mwgen. DO NOT EDIT.
+
+//go:build !test
+// +build !test
+
+package api
+
+import (
+	"context"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	otlog "github.com/opentracing/opentracing-go/log"
+)
+
+var _ mfxkit.Service = (*tracingMiddleware)(nil)
+
+type tracingMiddleware struct {
+	tracer opentracing.Tracer
+	svc    mfxkit.Service
+}
+
+// TracingMiddleware traces the core service methods using spans that are
+// children of the spans carried by the request context.
+func TracingMiddleware(svc mfxkit.Service, tracer opentracing.Tracer) mfxkit.Service {
+	return &tracingMiddleware{tracer, svc}
+}
+
+func (tm *tracingMiddleware) Ping(ctx context.Context, secret string) (greeting string, keyID string, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "ping")
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.Ping(ctx, secret)
+}
+
+func (tm *tracingMiddleware) CreateKits(ctx context.Context, token string, kits ...mfxkit.Kit) (res []mfxkit.Kit, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "create_kits")
+	span.SetTag("kits.count", len(kits))
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.CreateKits(ctx, token, kits...)
+}
+
+func (tm *tracingMiddleware) BulkCreateKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) (res []mfxkit.BulkResult, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "bulk_create_kits")
+	span.SetTag("atomic", atomic)
+	span.SetTag("kits.count", len(kits))
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.BulkCreateKits(ctx, token, atomic, kits...)
+}
+
+func (tm *tracingMiddleware) ViewKit(ctx context.Context, token string, id string) (res mfxkit.Kit, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "view_kit")
+	span.SetTag("id", id)
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.ViewKit(ctx, token, id)
+}
+
+func (tm *tracingMiddleware) UpdateKit(ctx context.Context, token string, kit mfxkit.Kit) (res mfxkit.Kit, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "update_kit")
+	span.SetTag("kit.id", kit.ID)
+	span.SetTag("kit.name", kit.Name)
+	span.SetTag("kit.revision", kit.Revision)
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.UpdateKit(ctx, token, kit)
+}
+
+func (tm *tracingMiddleware) ListKits(ctx context.Context, token string, pm mfxkit.PageMetadata) (res mfxkit.Page, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "list_kits")
+	span.SetTag("pm.offset", pm.Offset)
+	span.SetTag("pm.limit", pm.Limit)
+	span.SetTag("pm.order", pm.Order)
+	span.SetTag("pm.dir", pm.Dir)
+	span.SetTag("pm.name", pm.Name)
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.ListKits(ctx, token, pm)
+}
+
+func (tm *tracingMiddleware) RemoveKit(ctx context.Context, token string, id string, rev uint64) (err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "remove_kit")
+	span.SetTag("id", id)
+	span.SetTag("rev", rev)
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.RemoveKit(ctx, token, id, rev)
+}
+
+func (tm *tracingMiddleware) BulkRemoveKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) (res []mfxkit.BulkResult, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "bulk_remove_kits")
+	span.SetTag("atomic", atomic)
+	span.SetTag("kits.count", len(kits))
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.BulkRemoveKits(ctx, token, atomic, kits...)
+}
+
+func (tm *tracingMiddleware) RestoreKit(ctx context.Context, token string, id string) (res mfxkit.Kit, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "restore_kit")
+	span.SetTag("id", id)
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.RestoreKit(ctx, token, id)
+}
+
+func (tm *tracingMiddleware) PurgeKits(ctx context.Context, secret string) (res uint64, err error) {
+	span, ctx := opentracing.StartSpanFromContextWithTracer(ctx, tm.tracer, "purge_kits")
+	ctx, keyIDLabel := mfxkit.TrackKeyID(ctx)
+	defer func() {
+		span.SetTag("key_id", keyIDLabel())
+		finishSpan(span, err)
+	}()
+
+	return tm.svc.PurgeKits(ctx, secret)
+}
+
+func finishSpan(span opentracing.Span, err error) {
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(otlog.Error(err))
+	}
+	span.Finish()
+}
@@ -0,0 +1,8 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package auth contains the authenticators backed by the Mainflux services,
+// which identify the callers over gRPC instead of using the service secrets.
+// Users are identified by their tokens using the auth service, and things by
+// their keys and channels using the things service.
+package auth
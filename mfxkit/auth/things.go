@@ -0,0 +1,100 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/mainflux/mainflux"
+	"github.com/mainflux/mfxkit/mfxkit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ThingsAuthenticator identifies the Mainflux things by their keys, and
+// authorizes them to access the channels.
+type ThingsAuthenticator interface {
+	mfxkit.Authenticator
+	mfxkit.Authorizer
+}
+
+var _ ThingsAuthenticator = (*thingsAuth)(nil)
+
+type thingsAuth struct {
+	client  mainflux.ThingsServiceClient
+	timeout time.Duration
+	next    mfxkit.Authenticator
+}
+
+// NewThings instantiates the authenticator identifying the Mainflux things
+// by their keys using the things service. Tokens are treated as thing keys
+// when the context carries the channel ID, see mfxkit.WithChannelID, and the
+// thing is identified and authorized to access the channel, i.e. it must be
+// connected to it, by a single CanAccessByKey call. Other callers are
+// identified by the next authenticator, if any, or rejected. Callers that
+// already know the thing ID are authorized using Authorize instead. Every
+// call is bounded by the given timeout.
+func NewThings(client mainflux.ThingsServiceClient, timeout time.Duration, next mfxkit.Authenticator) ThingsAuthenticator {
+	return thingsAuth{
+		client:  client,
+		timeout: timeout,
+		next:    next,
+	}
+}
+
+func (ta thingsAuth) Identify(ctx context.Context, key string) (mfxkit.Identity, error) {
+	chanID, ok := mfxkit.ChannelIDFromContext(ctx)
+	if !ok {
+		if ta.next == nil {
+			return mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess
+		}
+		return ta.next.Identify(ctx, key)
+	}
+
+	if key == "" {
+		return mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ta.timeout)
+	defer cancel()
+
+	res, err := ta.client.CanAccessByKey(ctx, &mainflux.AccessByKeyReq{Token: key, ChanID: chanID})
+	if err != nil {
+		return mfxkit.Identity{}, decodeThingsError(err)
+	}
+
+	thingID := res.GetValue()
+	return mfxkit.Identity{ID: thingID, ThingID: thingID, ChannelID: chanID}, nil
+}
+
+func (ta thingsAuth) Authorize(ctx context.Context, thingID, chanID string) error {
+	if thingID == "" || chanID == "" {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ta.timeout)
+	defer cancel()
+
+	if _, err := ta.client.CanAccessByID(ctx, &mainflux.AccessByIDReq{ThingID: thingID, ChanID: chanID}); err != nil {
+		return decodeThingsError(err)
+	}
+
+	return nil
+}
+
+// decodeThingsError maps the errors of things denied access to the channel
+// to ErrForbidden.
+func decodeThingsError(err error) error {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return mfxkit.ErrUnauthorizedAccess
+	case codes.PermissionDenied, codes.NotFound:
+		return mfxkit.ErrForbidden
+	case codes.InvalidArgument:
+		return mfxkit.ErrMalformedEntity
+	default:
+		return err
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mainflux/mainflux/pkg/uuid"
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/auth"
+	"github.com/mainflux/mfxkit/mfxkit/hasher"
+	"github.com/mainflux/mfxkit/mfxkit/memory"
+	"github.com/mainflux/mfxkit/mfxkit/mfxkittest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	thingKey = "thing-key"
+	thingID  = "thing"
+	chanID   = "channel"
+)
+
+func TestIdentifyThing(t *testing.T) {
+	ts := mfxkittest.NewThingsServer(map[string]string{thingKey: thingID})
+	defer ts.Close()
+	as := mfxkittest.NewAuthServer(map[string]mfxkit.Identity{token: user})
+	defer as.Close()
+
+	authn := auth.NewThings(ts.Client, timeout, auth.NewUsers(as.Client, timeout))
+	ts.Connect(thingID, chanID)
+	thing := mfxkit.Identity{ID: thingID, ThingID: thingID, ChannelID: chanID}
+	chanCtx := mfxkit.WithChannelID(context.Background(), chanID)
+
+	cases := []struct {
+		desc  string
+		ctx   context.Context
+		token string
+		id    mfxkit.Identity
+		err   error
+	}{
+		{"identify thing with valid key", chanCtx, thingKey, thing, nil},
+		{"identify thing not connected to channel", mfxkit.WithChannelID(context.Background(), "other"), thingKey, mfxkit.Identity{}, mfxkit.ErrForbidden},
+		{"identify thing with invalid key", chanCtx, "invalid", mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess},
+		{"identify thing with empty key", chanCtx, "", mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess},
+		{"identify user without channel", context.Background(), token, user, nil},
+		{"identify thing without channel", context.Background(), thingKey, mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess},
+	}
+
+	for _, tc := range cases {
+		id, err := authn.Identify(tc.ctx, tc.token)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+		assert.Equal(t, tc.id, id, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.id, id))
+	}
+}
+
+func TestAuthorizeThing(t *testing.T) {
+	ts := mfxkittest.NewThingsServer(map[string]string{thingKey: thingID})
+	defer ts.Close()
+
+	authz := auth.NewThings(ts.Client, timeout, nil)
+	ts.Connect(thingID, chanID)
+
+	cases := []struct {
+		desc    string
+		thingID string
+		chanID  string
+		err     error
+	}{
+		{"authorize connected thing", thingID, chanID, nil},
+		{"authorize thing on other channel", thingID, "other", mfxkit.ErrForbidden},
+		{"authorize unknown thing", "unknown", chanID, mfxkit.ErrForbidden},
+		{"authorize thing without channel", thingID, "", mfxkit.ErrMalformedEntity},
+	}
+
+	for _, tc := range cases {
+		err := authz.Authorize(context.Background(), tc.thingID, tc.chanID)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+	}
+
+	ts.Disconnect(thingID, chanID)
+	err := authz.Authorize(context.Background(), thingID, chanID)
+	assert.Equal(t, mfxkit.ErrForbidden, err, fmt.Sprintf("authorize disconnected thing: expected %s got %v", mfxkit.ErrForbidden, err))
+}
+
+func TestAuthorizeThingByKey(t *testing.T) {
+	ts := mfxkittest.NewThingsServer(map[string]string{thingKey: thingID})
+	defer ts.Close()
+	ts.Connect(thingID, chanID)
+
+	authn := auth.NewThings(ts.Client, timeout, nil)
+	svc := mfxkit.New(mfxkit.StaticSecrets{}, hasher.NewArgon2id(), authn, memory.NewKitRepository(), uuid.NewMock(), time.Hour)
+
+	ctx := mfxkit.WithChannelID(context.Background(), chanID)
+	_, err := svc.ListKits(ctx, thingKey, mfxkit.PageMetadata{Limit: 10})
+	assert.Nil(t, err, fmt.Sprintf("list kits by connected thing: unexpected error: %s", err))
+
+	calls := map[string]int{"CanAccessByKey": 1, "Identify": 0, "CanAccessByID": 0}
+	for method, n := range calls {
+		assert.Equal(t, n, ts.Calls(method), fmt.Sprintf("list kits by connected thing: expected %d %s calls got %d", n, method, ts.Calls(method)))
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/mainflux/mainflux"
+	"github.com/mainflux/mfxkit/mfxkit"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ mfxkit.Authenticator = (*usersAuth)(nil)
+
+type usersAuth struct {
+	client  mainflux.AuthServiceClient
+	timeout time.Duration
+}
+
+// NewUsers instantiates the authenticator identifying the Mainflux users by
+// their tokens using the auth service. Every call is bounded by the given
+// timeout.
+func NewUsers(client mainflux.AuthServiceClient, timeout time.Duration) mfxkit.Authenticator {
+	return usersAuth{
+		client:  client,
+		timeout: timeout,
+	}
+}
+
+func (ua usersAuth) Identify(ctx context.Context, token string) (mfxkit.Identity, error) {
+	if token == "" {
+		return mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ua.timeout)
+	defer cancel()
+
+	res, err := ua.client.Identify(ctx, &mainflux.Token{Value: token})
+	if err != nil {
+		return mfxkit.Identity{}, decodeError(err)
+	}
+
+	return mfxkit.Identity{ID: res.GetId(), Email: res.GetEmail()}, nil
+}
+
+// decodeError maps the errors of rejected credentials to the service error,
+// and keeps the others, e.g. the unavailable service.
+func decodeError(err error) error {
+	switch status.Code(err) {
+	case codes.Unauthenticated, codes.InvalidArgument, codes.PermissionDenied, codes.NotFound:
+		return mfxkit.ErrUnauthorizedAccess
+	default:
+		return err
+	}
+}
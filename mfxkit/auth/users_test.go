@@ -0,0 +1,57 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package auth_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/auth"
+	"github.com/mainflux/mfxkit/mfxkit/mfxkittest"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	token   = "token"
+	timeout = time.Second
+)
+
+var user = mfxkit.Identity{ID: "user", Email: "user@example.com"}
+
+func TestIdentifyUser(t *testing.T) {
+	as := mfxkittest.NewAuthServer(map[string]mfxkit.Identity{token: user})
+	defer as.Close()
+
+	authn := auth.NewUsers(as.Client, timeout)
+
+	cases := []struct {
+		desc  string
+		token string
+		id    mfxkit.Identity
+		err   error
+	}{
+		{"identify user with valid token", token, user, nil},
+		{"identify user with invalid token", "invalid", mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess},
+		{"identify user with empty token", "", mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess},
+	}
+
+	for _, tc := range cases {
+		id, err := authn.Identify(context.Background(), tc.token)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+		assert.Equal(t, tc.id, id, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.id, id))
+	}
+}
+
+func TestIdentifyUserUnavailable(t *testing.T) {
+	as := mfxkittest.NewAuthServer(map[string]mfxkit.Identity{token: user})
+	authn := auth.NewUsers(as.Client, timeout)
+	as.Close()
+
+	_, err := authn.Identify(context.Background(), token)
+	assert.NotNil(t, err, "identify user with unavailable auth service: expected error")
+	assert.NotEqual(t, mfxkit.ErrUnauthorizedAccess, err, "identify user with unavailable auth service: expected the error not to reject the credentials")
+}
@@ -0,0 +1,6 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bolt contains repository implementations using bbolt embedded
+// key-value store.
+package bolt
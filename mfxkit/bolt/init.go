@@ -0,0 +1,38 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package bolt
+
+import (
+	"os"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	fileMode    os.FileMode = 0600
+	openTimeout             = 5 * time.Second
+)
+
+var kitsBucket = []byte("kits")
+
+// Connect opens the database file at the given path, creating it if it does
+// not exist, and prepares the buckets used by the repositories.
+func Connect(path string) (*bolt.DB, error) {
+	db, err := bolt.Open(path, fileMode, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(kitsBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
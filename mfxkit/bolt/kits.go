@@ -0,0 +1,287 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package bolt
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	bolt "go.etcd.io/bbolt"
+)
+
+var _ mfxkit.KitRepository = (*kitRepository)(nil)
+
+// Kits are stored as JSON documents in the kits bucket, nested in the
+// bucket of their owner and keyed by their identifiers.
+type kitRepository struct {
+	db *bolt.DB
+}
+
+// NewKitRepository instantiates a bbolt implementation of kit repository.
+func NewKitRepository(db *bolt.DB) mfxkit.KitRepository {
+	return &kitRepository{
+		db: db,
+	}
+}
+
+func (kr kitRepository) Save(_ context.Context, kits ...mfxkit.Kit) ([]mfxkit.Kit, error) {
+	err := kr.db.Update(func(tx *bolt.Tx) error {
+		for i, kit := range kits {
+			b, err := tx.Bucket(kitsBucket).CreateBucketIfNotExists([]byte(kit.Owner))
+			if err != nil {
+				return &mfxkit.ItemError{Index: i, Err: err}
+			}
+
+			if b.Get([]byte(kit.ID)) != nil {
+				return &mfxkit.ItemError{Index: i, Err: mfxkit.ErrConflict}
+			}
+
+			if err := put(b, kit); err != nil {
+				return &mfxkit.ItemError{Index: i, Err: err}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return []mfxkit.Kit{}, err
+	}
+
+	return kits, nil
+}
+
+func (kr kitRepository) Update(_ context.Context, kit mfxkit.Kit) (mfxkit.Kit, error) {
+	var updated mfxkit.Kit
+
+	err := kr.db.Update(func(tx *bolt.Tx) error {
+		b, k, err := retrieve(tx, kit.Owner, kit.ID)
+		if err != nil {
+			return err
+		}
+
+		if kit.Revision != 0 && kit.Revision != k.Revision {
+			return mfxkit.ErrConflict
+		}
+
+		k.Name = kit.Name
+		k.Metadata = kit.Metadata
+		k.UpdatedAt = kit.UpdatedAt
+		k.Revision++
+		updated = k
+
+		return put(b, k)
+	})
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return updated, nil
+}
+
+func (kr kitRepository) RetrieveByID(_ context.Context, owner, id string) (mfxkit.Kit, error) {
+	var kit mfxkit.Kit
+
+	err := kr.db.View(func(tx *bolt.Tx) error {
+		_, k, err := retrieve(tx, owner, id)
+		kit = k
+		return err
+	})
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return kit, nil
+}
+
+func (kr kitRepository) RetrieveAll(_ context.Context, owner string, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	kits := []mfxkit.Kit{}
+
+	err := kr.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(kitsBucket).Bucket([]byte(owner))
+		if b == nil {
+			return nil
+		}
+
+		return b.ForEach(func(_, v []byte) error {
+			var kit mfxkit.Kit
+			if err := json.Unmarshal(v, &kit); err != nil {
+				return err
+			}
+
+			if kit.DeletedAt.IsZero() {
+				kits = append(kits, kit)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return mfxkit.Page{}, err
+	}
+
+	return pm.Apply(kits), nil
+}
+
+func (kr kitRepository) Remove(_ context.Context, owner, id string, rev uint64, at time.Time) error {
+	return kr.db.Update(func(tx *bolt.Tx) error {
+		return remove(tx, owner, id, rev, at)
+	})
+}
+
+func (kr kitRepository) BulkRemove(_ context.Context, owner string, at time.Time, kits ...mfxkit.Kit) error {
+	return kr.db.Update(func(tx *bolt.Tx) error {
+		for i, kit := range kits {
+			if err := remove(tx, owner, kit.ID, kit.Revision, at); err != nil {
+				return &mfxkit.ItemError{Index: i, Err: err}
+			}
+		}
+
+		return nil
+	})
+}
+
+func (kr kitRepository) Restore(_ context.Context, owner, id string, since time.Time) (mfxkit.Kit, error) {
+	var restored mfxkit.Kit
+
+	err := kr.db.Update(func(tx *bolt.Tx) error {
+		b, k, err := get(tx, owner, id)
+		if err != nil {
+			return err
+		}
+
+		if k.DeletedAt.IsZero() || k.DeletedAt.Before(since) {
+			return mfxkit.ErrNotFound
+		}
+
+		k.DeletedAt = time.Time{}
+		k.Revision++
+		restored = k
+
+		return put(b, k)
+	})
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return restored, nil
+}
+
+func (kr kitRepository) Purge(_ context.Context, before time.Time) (uint64, error) {
+	var n uint64
+
+	err := kr.db.Update(func(tx *bolt.Tx) error {
+		// Buckets must not be modified while iterating using ForEach, so
+		// expired kits are collected first.
+		owners := [][]byte{}
+		err := tx.Bucket(kitsBucket).ForEach(func(owner, _ []byte) error {
+			owners = append(owners, owner)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, owner := range owners {
+			b := tx.Bucket(kitsBucket).Bucket(owner)
+			if b == nil {
+				continue
+			}
+
+			expired := [][]byte{}
+			err := b.ForEach(func(id, v []byte) error {
+				var kit mfxkit.Kit
+				if err := json.Unmarshal(v, &kit); err != nil {
+					return err
+				}
+
+				if !kit.DeletedAt.IsZero() && kit.DeletedAt.Before(before) {
+					expired = append(expired, id)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, id := range expired {
+				if err := b.Delete(id); err != nil {
+					return err
+				}
+				n++
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return n, nil
+}
+
+// remove marks the kit as deleted. Removing a missing kit is not an error,
+// while removing a kit with a different revision is.
+func remove(tx *bolt.Tx, owner, id string, rev uint64, at time.Time) error {
+	b, k, err := retrieve(tx, owner, id)
+	if err == mfxkit.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if rev != 0 && rev != k.Revision {
+		return mfxkit.ErrConflict
+	}
+
+	k.DeletedAt = at
+	k.Revision++
+
+	return put(b, k)
+}
+
+// retrieve returns the kit that is not removed, along with the bucket of its
+// owner.
+func retrieve(tx *bolt.Tx, owner, id string) (*bolt.Bucket, mfxkit.Kit, error) {
+	b, k, err := get(tx, owner, id)
+	if err != nil {
+		return nil, mfxkit.Kit{}, err
+	}
+
+	if !k.DeletedAt.IsZero() {
+		return nil, mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	return b, k, nil
+}
+
+func get(tx *bolt.Tx, owner, id string) (*bolt.Bucket, mfxkit.Kit, error) {
+	b := tx.Bucket(kitsBucket).Bucket([]byte(owner))
+	if b == nil {
+		return nil, mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	v := b.Get([]byte(id))
+	if v == nil {
+		return nil, mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	var kit mfxkit.Kit
+	if err := json.Unmarshal(v, &kit); err != nil {
+		return nil, mfxkit.Kit{}, err
+	}
+
+	return b, kit, nil
+}
+
+func put(b *bolt.Bucket, kit mfxkit.Kit) error {
+	v, err := json.Marshal(kit)
+	if err != nil {
+		return mfxkit.ErrMalformedEntity
+	}
+
+	return b.Put([]byte(kit.ID), v)
+}
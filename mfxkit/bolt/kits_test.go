@@ -0,0 +1,25 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package bolt_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/bolt"
+	"github.com/mainflux/mfxkit/mfxkit/repotest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKitRepository(t *testing.T) {
+	repotest.TestKitRepository(t, func(t *testing.T) mfxkit.KitRepository {
+		db, err := bolt.Connect(filepath.Join(t.TempDir(), "mfxkit.db"))
+		require.Nil(t, err, fmt.Sprintf("connect to database: unexpected error: %s", err))
+		t.Cleanup(func() { db.Close() })
+
+		return bolt.NewKitRepository(db)
+	})
+}
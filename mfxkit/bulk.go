@@ -0,0 +1,56 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrBulkAborted indicates that the bulk operation item was not applied
+// because another item of the same all-or-nothing operation failed.
+var ErrBulkAborted = errors.New("bulk operation aborted")
+
+// BulkResult contains the outcome of a single bulk operation item.
+type BulkResult struct {
+	Kit Kit
+	Err error
+}
+
+// ItemError indicates failure of the bulk operation item at the given index.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (ie *ItemError) Error() string {
+	return fmt.Sprintf("bulk item %d: %s", ie.Index, ie.Err)
+}
+
+// Unwrap returns the cause of the item failure.
+func (ie *ItemError) Unwrap() error {
+	return ie.Err
+}
+
+// AbortedResults returns results of the all-or-nothing bulk operation over
+// the given kits that failed with the given error. If the error is an
+// ItemError, only the failed item reports its cause and all the others
+// report ErrBulkAborted.
+func AbortedResults(kits []Kit, err error) []BulkResult {
+	var ie *ItemError
+	isItem := errors.As(err, &ie)
+
+	res := make([]BulkResult, len(kits))
+	for i, kit := range kits {
+		res[i] = BulkResult{Kit: kit, Err: err}
+		if isItem {
+			res[i].Err = ErrBulkAborted
+			if i == ie.Index {
+				res[i].Err = ie.Err
+			}
+		}
+	}
+
+	return res
+}
@@ -1,7 +1,7 @@
 // Copyright (c) Mainflux
 // SPDX-License-Identifier: Apache-2.0
 
-// Package things contains the domain concept definitions needed to support
+// Package mfxkit contains the domain concept definitions needed to support
 // Mainflux mfxkit service functionality.
 
 package mfxkit
@@ -0,0 +1,15 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit
+
+// Hasher specifies an API for generating hashes of the service secret and
+// verifying secrets against them.
+type Hasher interface {
+	// Hash generates the hashed secret.
+	Hash(secret string) (string, error)
+
+	// Compare compares the secret with the hashed one in constant time. It
+	// returns a non-nil error if they do not match.
+	Compare(secret, hash string) error
+}
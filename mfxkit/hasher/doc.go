@@ -0,0 +1,7 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package hasher contains the secret hasher implementations. Hashes are
+// generated using bcrypt or argon2id, and every hasher verifies secrets
+// against hashes generated by either of them.
+package hasher
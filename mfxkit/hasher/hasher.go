@@ -0,0 +1,184 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// Argon2id identifies the argon2id hashing algorithm.
+	Argon2id = "argon2id"
+
+	// Bcrypt identifies the bcrypt hashing algorithm.
+	Bcrypt = "bcrypt"
+
+	argon2Prefix  = "$argon2id$"
+	argon2Time    = 2
+	argon2Memory  = 19 * 1024
+	argon2Threads = 1
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+
+	// argon2MaxMemory bounds the memory, in KiB, of the verified hashes,
+	// so that a malformed hash cannot exhaust the service memory.
+	argon2MaxMemory = 1024 * 1024
+)
+
+var (
+	// ErrUnknownAlgorithm indicates an unsupported hashing algorithm.
+	ErrUnknownAlgorithm = errors.New("unknown hashing algorithm")
+
+	// ErrMalformedHash indicates a hash that was not generated by any of the
+	// supported algorithms.
+	ErrMalformedHash = errors.New("malformed hash")
+
+	bcryptPrefixes = []string{"$2a$", "$2b$", "$2y$"}
+	b64            = base64.RawStdEncoding
+)
+
+// New instantiates the hasher using the given algorithm with its default
+// parameters.
+func New(alg string) (mfxkit.Hasher, error) {
+	switch alg {
+	case Argon2id:
+		return NewArgon2id(), nil
+	case Bcrypt:
+		return NewBcrypt(bcrypt.DefaultCost), nil
+	default:
+		return nil, ErrUnknownAlgorithm
+	}
+}
+
+// IsHash reports whether the value is a hash generated by one of the
+// supported algorithms, rather than a plain secret.
+func IsHash(val string) bool {
+	return isArgon2id(val) || isBcrypt(val)
+}
+
+var _ mfxkit.Hasher = (*argon2idHasher)(nil)
+
+type argon2idHasher struct{}
+
+// NewArgon2id instantiates the hasher generating argon2id hashes.
+func NewArgon2id() mfxkit.Hasher {
+	return argon2idHasher{}
+}
+
+func (argon2idHasher) Hash(secret string) (string, error) {
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(secret), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s", argon2Prefix, argon2.Version, argon2Memory, argon2Time, argon2Threads, b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+func (argon2idHasher) Compare(secret, hash string) error {
+	return compare(secret, hash)
+}
+
+var _ mfxkit.Hasher = (*bcryptHasher)(nil)
+
+type bcryptHasher struct {
+	cost int
+}
+
+// NewBcrypt instantiates the hasher generating bcrypt hashes of the given
+// cost.
+func NewBcrypt(cost int) mfxkit.Hasher {
+	return bcryptHasher{cost: cost}
+}
+
+func (bh bcryptHasher) Hash(secret string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bh.cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+func (bcryptHasher) Compare(secret, hash string) error {
+	return compare(secret, hash)
+}
+
+func compare(secret, hash string) error {
+	switch {
+	case isArgon2id(hash):
+		return compareArgon2id(secret, hash)
+	case isBcrypt(hash):
+		if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret)); err != nil {
+			return mfxkit.ErrUnauthorizedAccess
+		}
+		return nil
+	default:
+		return ErrMalformedHash
+	}
+}
+
+// compareArgon2id verifies the secret against the hash in the PHC string
+// format, i.e. $argon2id$v=19$m=<memory>,t=<time>,p=<threads>$<salt>$<key>.
+func compareArgon2id(secret, hash string) error {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return ErrMalformedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return ErrMalformedHash
+	}
+
+	var memory, time uint32
+	var threads uint8
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil {
+		return ErrMalformedHash
+	}
+	if time < 1 || threads < 1 || memory < 8*uint32(threads) || memory > argon2MaxMemory {
+		return ErrMalformedHash
+	}
+
+	salt, err := b64.DecodeString(parts[4])
+	if err != nil {
+		return ErrMalformedHash
+	}
+
+	key, err := b64.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return ErrMalformedHash
+	}
+
+	actual := argon2.IDKey([]byte(secret), salt, time, memory, threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(actual, key) != 1 {
+		return mfxkit.ErrUnauthorizedAccess
+	}
+
+	return nil
+}
+
+func isArgon2id(val string) bool {
+	return strings.HasPrefix(val, argon2Prefix)
+}
+
+func isBcrypt(val string) bool {
+	for _, p := range bcryptPrefixes {
+		if strings.HasPrefix(val, p) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package hasher_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/hasher"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	secret = "secret"
+	salt   = "c29tZXNhbHRzb21lc2FsdA"
+	key    = "bWFsZm9ybWVkbWFsZm9ybWVkbWFsZm9ybWVkbWFsZm8"
+)
+
+func TestCompare(t *testing.T) {
+	h := hasher.NewArgon2id()
+	argon2Hash, err := h.Hash(secret)
+	require.Nil(t, err, fmt.Sprintf("hash secret: unexpected error: %s", err))
+
+	bcryptHash, err := hasher.NewBcrypt(4).Hash(secret)
+	require.Nil(t, err, fmt.Sprintf("hash secret: unexpected error: %s", err))
+
+	cases := []struct {
+		desc   string
+		secret string
+		hash   string
+		err    error
+	}{
+		{"argon2id hash", secret, argon2Hash, nil},
+		{"argon2id hash with wrong secret", "wrong", argon2Hash, mfxkit.ErrUnauthorizedAccess},
+		{"bcrypt hash", secret, bcryptHash, nil},
+		{"bcrypt hash with wrong secret", "wrong", bcryptHash, mfxkit.ErrUnauthorizedAccess},
+		{"plain secret", secret, secret, hasher.ErrMalformedHash},
+		{"missing parts", secret, "$argon2id$v=19$m=19456,t=2,p=1$" + salt, hasher.ErrMalformedHash},
+		{"unknown version", secret, fmt.Sprintf("$argon2id$v=16$m=19456,t=2,p=1$%s$%s", salt, key), hasher.ErrMalformedHash},
+		{"malformed params", secret, fmt.Sprintf("$argon2id$v=19$m=x,t=2,p=1$%s$%s", salt, key), hasher.ErrMalformedHash},
+		{"zero time", secret, fmt.Sprintf("$argon2id$v=19$m=19456,t=0,p=1$%s$%s", salt, key), hasher.ErrMalformedHash},
+		{"zero threads", secret, fmt.Sprintf("$argon2id$v=19$m=19456,t=2,p=0$%s$%s", salt, key), hasher.ErrMalformedHash},
+		{"too little memory", secret, fmt.Sprintf("$argon2id$v=19$m=7,t=2,p=1$%s$%s", salt, key), hasher.ErrMalformedHash},
+		{"too much memory", secret, fmt.Sprintf("$argon2id$v=19$m=4294967295,t=2,p=1$%s$%s", salt, key), hasher.ErrMalformedHash},
+		{"malformed salt", secret, fmt.Sprintf("$argon2id$v=19$m=19456,t=2,p=1$%s$%s", "!", key), hasher.ErrMalformedHash},
+		{"empty key", secret, fmt.Sprintf("$argon2id$v=19$m=19456,t=2,p=1$%s$", salt), hasher.ErrMalformedHash},
+	}
+
+	for _, tc := range cases {
+		err := h.Compare(tc.secret, tc.hash)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+	}
+}
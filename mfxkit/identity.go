@@ -0,0 +1,80 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit
+
+import "context"
+
+// Identity identifies the caller of the service.
+type Identity struct {
+	// ID identifies the caller, and owns the kits the caller creates.
+	ID string
+
+	// Email is the email of the caller, if known.
+	Email string
+
+	// ThingID identifies the Mainflux thing the caller authenticated as
+	// using its key, in which case the thing ID is the caller ID as well.
+	// It is empty for users.
+	ThingID string
+
+	// ChannelID identifies the channel the thing was authorized to access
+	// when it was identified, if any, so that the service does not
+	// authorize it again.
+	ChannelID string
+}
+
+// Authenticator identifies the callers by their tokens, e.g. using the
+// Mainflux auth service, instead of the service secrets.
+type Authenticator interface {
+	// Identify returns the identity of the token owner.
+	Identify(ctx context.Context, token string) (Identity, error)
+}
+
+// Authorizer authorizes the things identified by the Authenticator to
+// access the Mainflux channels. The service authorizes the thing callers
+// whenever the context carries the channel ID, see WithChannelID.
+type Authorizer interface {
+	// Authorize checks that the thing can access the channel.
+	Authorize(ctx context.Context, thingID, chanID string) error
+}
+
+type identityCtxKey struct{}
+
+type channelIDCtxKey struct{}
+
+// WithIdentity returns the context carrying the identity of the caller.
+func WithIdentity(ctx context.Context, id Identity) context.Context {
+	return context.WithValue(ctx, identityCtxKey{}, id)
+}
+
+// IdentityFromContext returns the identity of the caller carried by the
+// context, if any.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityCtxKey{}).(Identity)
+	return id, ok
+}
+
+// ThingIDFromContext returns the ID of the thing the caller authenticated
+// as, if any.
+func ThingIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := IdentityFromContext(ctx)
+	if !ok || id.ThingID == "" {
+		return "", false
+	}
+
+	return id.ThingID, true
+}
+
+// WithChannelID returns the context carrying the ID of the Mainflux channel
+// the caller requests access through, e.g. as read by the transport.
+func WithChannelID(ctx context.Context, chanID string) context.Context {
+	return context.WithValue(ctx, channelIDCtxKey{}, chanID)
+}
+
+// ChannelIDFromContext returns the ID of the channel carried by the context,
+// if any.
+func ChannelIDFromContext(ctx context.Context) (string, bool) {
+	chanID, ok := ctx.Value(channelIDCtxKey{}).(string)
+	return chanID, ok && chanID != ""
+}
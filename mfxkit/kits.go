@@ -0,0 +1,71 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit
+
+import (
+	"context"
+	"time"
+)
+
+// Metadata to be used for mfxkit kit for customized describing of
+// particular kit.
+type Metadata map[string]interface{}
+
+// Kit represents a Mainflux kit. Each kit is owned by one user, and it is
+// assigned with the unique identifier. Revision is incremented on every
+// update and is used to detect concurrent modifications. Removed kits keep
+// the time of removal in DeletedAt until they are purged.
+type Kit struct {
+	ID        string
+	Owner     string
+	Name      string
+	Metadata  Metadata
+	Revision  uint64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	DeletedAt time.Time
+}
+
+// KitRepository specifies a kit persistence API.
+type KitRepository interface {
+	// Save persists multiple kits. Kits are saved using a transaction. If one
+	// kit fails then none will be saved and ItemError may be returned to
+	// indicate which one. Successful operation is indicated by non-nil error
+	// response.
+	Save(ctx context.Context, kits ...Kit) ([]Kit, error)
+
+	// Update performs an update to the existing kit and returns it with the
+	// incremented revision. If the kit revision is set, the update is applied
+	// only if it matches the stored revision, otherwise ErrConflict is
+	// returned. A non-nil error is returned to indicate operation failure.
+	Update(ctx context.Context, kit Kit) (Kit, error)
+
+	// RetrieveByID retrieves the kit having the provided identifier, that is
+	// owned by the specified user.
+	RetrieveByID(ctx context.Context, owner, id string) (Kit, error)
+
+	// RetrieveAll retrieves the subset of kits owned by the specified user.
+	RetrieveAll(ctx context.Context, owner string, pm PageMetadata) (Page, error)
+
+	// Remove marks the kit having the provided identifier, that is owned by
+	// the specified user, as deleted at the given time. Removed kits are
+	// hidden from every other method except Restore and Purge. If the
+	// revision is set, the kit is removed only if it matches the stored
+	// revision, otherwise ErrConflict is returned.
+	Remove(ctx context.Context, owner, id string, rev uint64, at time.Time) error
+
+	// BulkRemove marks multiple kits, owned by the specified user, as deleted
+	// at the given time using a transaction. Kits are matched by identifier
+	// and revision the same way Remove does. If one kit fails then none will
+	// be removed and ItemError is returned to indicate which one.
+	BulkRemove(ctx context.Context, owner string, at time.Time, kits ...Kit) error
+
+	// Restore reverts removal of the kit having the provided identifier, that
+	// is owned by the specified user, if it was removed after the given time.
+	Restore(ctx context.Context, owner, id string, since time.Time) (Kit, error)
+
+	// Purge permanently deletes all kits removed before the given time and
+	// returns the number of deleted kits.
+	Purge(ctx context.Context, before time.Time) (uint64, error)
+}
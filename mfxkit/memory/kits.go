@@ -0,0 +1,206 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+var _ mfxkit.KitRepository = (*kitRepository)(nil)
+
+type kitRepository struct {
+	mu   sync.RWMutex
+	kits map[string]mfxkit.Kit
+}
+
+// NewKitRepository instantiates an in-memory implementation of kit
+// repository.
+func NewKitRepository() mfxkit.KitRepository {
+	return &kitRepository{
+		kits: make(map[string]mfxkit.Kit),
+	}
+}
+
+func (kr *kitRepository) Save(_ context.Context, kits ...mfxkit.Kit) ([]mfxkit.Kit, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	for _, kit := range kits {
+		kr.kits[kit.ID] = copyKit(kit)
+	}
+
+	return kits, nil
+}
+
+func (kr *kitRepository) Update(_ context.Context, kit mfxkit.Kit) (mfxkit.Kit, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	k, ok := kr.kits[kit.ID]
+	if !ok || k.Owner != kit.Owner || !k.DeletedAt.IsZero() {
+		return mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	if kit.Revision != 0 && kit.Revision != k.Revision {
+		return mfxkit.Kit{}, mfxkit.ErrConflict
+	}
+
+	k.Name = kit.Name
+	k.Metadata = kit.Metadata
+	k.UpdatedAt = kit.UpdatedAt
+	k.Revision++
+	kr.kits[kit.ID] = copyKit(k)
+
+	return copyKit(k), nil
+}
+
+func (kr *kitRepository) RetrieveByID(_ context.Context, owner, id string) (mfxkit.Kit, error) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	k, ok := kr.kits[id]
+	if !ok || k.Owner != owner || !k.DeletedAt.IsZero() {
+		return mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	return copyKit(k), nil
+}
+
+func (kr *kitRepository) RetrieveAll(_ context.Context, owner string, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	kr.mu.RLock()
+	defer kr.mu.RUnlock()
+
+	kits := []mfxkit.Kit{}
+	for _, k := range kr.kits {
+		if k.Owner == owner && k.DeletedAt.IsZero() {
+			kits = append(kits, copyKit(k))
+		}
+	}
+
+	return pm.Apply(kits), nil
+}
+
+func (kr *kitRepository) Remove(_ context.Context, owner, id string, rev uint64, at time.Time) error {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	k, ok := kr.kits[id]
+	if !ok || k.Owner != owner || !k.DeletedAt.IsZero() {
+		return nil
+	}
+
+	if rev != 0 && rev != k.Revision {
+		return mfxkit.ErrConflict
+	}
+
+	k.DeletedAt = at
+	k.Revision++
+	kr.kits[id] = k
+
+	return nil
+}
+
+func (kr *kitRepository) BulkRemove(_ context.Context, owner string, at time.Time, kits ...mfxkit.Kit) error {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	for i, kit := range kits {
+		k, ok := kr.kits[kit.ID]
+		if !ok || k.Owner != owner || !k.DeletedAt.IsZero() {
+			continue
+		}
+
+		if kit.Revision != 0 && kit.Revision != k.Revision {
+			return &mfxkit.ItemError{Index: i, Err: mfxkit.ErrConflict}
+		}
+	}
+
+	for _, kit := range kits {
+		k, ok := kr.kits[kit.ID]
+		if !ok || k.Owner != owner || !k.DeletedAt.IsZero() {
+			continue
+		}
+
+		k.DeletedAt = at
+		k.Revision++
+		kr.kits[kit.ID] = k
+	}
+
+	return nil
+}
+
+func (kr *kitRepository) Restore(_ context.Context, owner, id string, since time.Time) (mfxkit.Kit, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	k, ok := kr.kits[id]
+	if !ok || k.Owner != owner || k.DeletedAt.IsZero() || k.DeletedAt.Before(since) {
+		return mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	k.DeletedAt = time.Time{}
+	k.Revision++
+	kr.kits[id] = k
+
+	return copyKit(k), nil
+}
+
+func (kr *kitRepository) Purge(_ context.Context, before time.Time) (uint64, error) {
+	kr.mu.Lock()
+	defer kr.mu.Unlock()
+
+	var n uint64
+	for id, k := range kr.kits {
+		if !k.DeletedAt.IsZero() && k.DeletedAt.Before(before) {
+			delete(kr.kits, id)
+			n++
+		}
+	}
+
+	return n, nil
+}
+
+// copyKit detaches metadata of the stored kit from the one held by the
+// caller, so that neither side can mutate the other.
+func copyKit(k mfxkit.Kit) mfxkit.Kit {
+	if k.Metadata == nil {
+		return k
+	}
+
+	k.Metadata = copyMap(k.Metadata)
+
+	return k
+}
+
+// copyValue copies the nested objects and arrays of the metadata value,
+// the only mutable values JSON metadata is made of.
+func copyValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return map[string]interface{}(copyMap(val))
+	case mfxkit.Metadata:
+		return copyMap(val)
+	case []interface{}:
+		arr := make([]interface{}, len(val))
+		for i, item := range val {
+			arr[i] = copyValue(item)
+		}
+		return arr
+	default:
+		return v
+	}
+}
+
+func copyMap(m map[string]interface{}) mfxkit.Metadata {
+	md := make(mfxkit.Metadata, len(m))
+	for key, val := range m {
+		md[key] = copyValue(val)
+	}
+
+	return md
+}
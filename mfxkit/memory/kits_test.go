@@ -0,0 +1,54 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package memory_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/memory"
+	"github.com/mainflux/mfxkit/mfxkit/repotest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const owner = "owner@example.com"
+
+func TestKitRepository(t *testing.T) {
+	repotest.TestKitRepository(t, func(*testing.T) mfxkit.KitRepository {
+		return memory.NewKitRepository()
+	})
+}
+
+func TestNestedMetadataIsolation(t *testing.T) {
+	repo := memory.NewKitRepository()
+
+	kit := mfxkit.Kit{
+		ID:    "kit",
+		Owner: owner,
+		Metadata: mfxkit.Metadata{
+			"location": map[string]interface{}{"room": "lab"},
+			"tags":     []interface{}{"a", map[string]interface{}{"b": "c"}},
+		},
+	}
+	_, err := repo.Save(context.Background(), kit)
+	require.Nil(t, err, fmt.Sprintf("save kit: unexpected error: %s", err))
+
+	kit.Metadata["location"].(map[string]interface{})["room"] = "saved"
+
+	retrieved, err := repo.RetrieveByID(context.Background(), owner, kit.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve kit: unexpected error: %s", err))
+	retrieved.Metadata["location"].(map[string]interface{})["room"] = "retrieved"
+	retrieved.Metadata["tags"].([]interface{})[1].(map[string]interface{})["b"] = "retrieved"
+
+	stored, err := repo.RetrieveByID(context.Background(), owner, kit.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve kit: unexpected error: %s", err))
+	expected := mfxkit.Metadata{
+		"location": map[string]interface{}{"room": "lab"},
+		"tags":     []interface{}{"a", map[string]interface{}{"b": "c"}},
+	}
+	assert.Equal(t, expected, stored.Metadata, "nested metadata: expected stored kit to be detached from the callers")
+}
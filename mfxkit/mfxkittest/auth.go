@@ -0,0 +1,110 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkittest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/mainflux/mainflux"
+	"github.com/mainflux/mfxkit/mfxkit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AuthServer is a running in-process fake of the Mainflux auth gRPC service.
+// It identifies the users by the tokens it knows, and implements no other
+// RPC.
+type AuthServer struct {
+	// Addr is the address the server listens on.
+	Addr string
+
+	// Client is a client of the server.
+	Client mainflux.AuthServiceClient
+
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	svc    *authService
+}
+
+// NewAuthServer starts and returns a new fake auth server on the loopback
+// interface. The users map the tokens to the identities of their owners.
+// The caller should call Close when finished, to shut it down.
+func NewAuthServer(users map[string]mfxkit.Identity) *AuthServer {
+	svc := &authService{users: make(map[string]mfxkit.Identity)}
+	for token, id := range users {
+		svc.users[token] = id
+	}
+
+	server, conn, addr := serveGRPC(func(server *grpc.Server) {
+		mainflux.RegisterAuthServiceServer(server, svc)
+	})
+
+	return &AuthServer{
+		Addr:   addr,
+		Client: mainflux.NewAuthServiceClient(conn),
+		server: server,
+		conn:   conn,
+		svc:    svc,
+	}
+}
+
+// AddUser makes the server identify the owner of the token, e.g. to issue
+// a token while the server is running.
+func (as *AuthServer) AddUser(token string, id mfxkit.Identity) {
+	as.svc.mu.Lock()
+	defer as.svc.mu.Unlock()
+
+	as.svc.users[token] = id
+}
+
+// Close closes the client connection and shuts down the server.
+func (as *AuthServer) Close() {
+	as.conn.Close()
+	as.server.Stop()
+}
+
+type authService struct {
+	mainflux.UnimplementedAuthServiceServer
+
+	mu    sync.Mutex
+	users map[string]mfxkit.Identity
+}
+
+func (svc *authService) Identify(_ context.Context, token *mainflux.Token) (*mainflux.UserIdentity, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+
+	id, ok := svc.users[token.GetValue()]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, mfxkit.ErrUnauthorizedAccess.Error())
+	}
+
+	return &mainflux.UserIdentity{Id: id.ID, Email: id.Email}, nil
+}
+
+// serveGRPC starts the gRPC server with the registered services on the
+// loopback interface, and connects to it.
+func serveGRPC(register func(*grpc.Server)) (*grpc.Server, *grpc.ClientConn, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(fmt.Sprintf("mfxkittest: failed to listen: %s", err))
+	}
+
+	server := grpc.NewServer()
+	register(server)
+	go server.Serve(listener)
+
+	addr := listener.Addr().String()
+	conn, err := grpc.Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		server.Stop()
+		panic(fmt.Sprintf("mfxkittest: failed to connect to %s: %s", addr, err))
+	}
+
+	return server, conn, addr
+}
@@ -0,0 +1,16 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mfxkittest provides an in-process mfxkit server for end-to-end API
+// tests. Every server has its own repository and Prometheus registry and
+// uses a no-op tracer, so tests using it can run in parallel:
+//
+//	func TestPing(t *testing.T) {
+//		t.Parallel()
+//		ts := mfxkittest.NewServer(mfxkittest.Options{Secret: "secret"})
+//		defer ts.Close()
+//
+//		greeting, keyID, err := ts.SDK.Ping(context.Background(), "secret")
+//		...
+//	}
+package mfxkittest
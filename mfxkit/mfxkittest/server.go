@@ -0,0 +1,137 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkittest
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/mainflux/mainflux"
+	log "github.com/mainflux/mainflux/logger"
+	"github.com/mainflux/mainflux/pkg/uuid"
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/api"
+	httpapi "github.com/mainflux/mfxkit/mfxkit/api/mfxkit/http"
+	"github.com/mainflux/mfxkit/mfxkit/hasher"
+	"github.com/mainflux/mfxkit/mfxkit/memory"
+	sdk "github.com/mainflux/mfxkit/pkg/sdk/go"
+	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// DefSecret is the service secret used when none is provided.
+	DefSecret = "secret"
+
+	// DefKeyID is the key ID of the service secret.
+	DefKeyID = "default"
+)
+
+// Options contains the test server configuration. The zero value creates
+// a server backed by the in-memory repository that accepts DefSecret.
+type Options struct {
+	// Secret is the plain service secret. The server verifies callers
+	// against its bcrypt hash of the minimal cost, to keep tests fast.
+	Secret string
+
+	// Kits is the kit repository. The in-memory repository is used if not
+	// set.
+	Kits mfxkit.KitRepository
+
+	// IDProvider generates kit identifiers. The mock provider, generating
+	// predictable identifiers, is used if not set.
+	IDProvider mainflux.IDProvider
+
+	// Retention is the period within which removed kits can be restored.
+	Retention time.Duration
+
+	// Auth, if set, identifies the callers managing kits instead of the
+	// secret, e.g. using the client of an AuthServer.
+	Auth mfxkit.Authenticator
+
+	// Service, if set, is served instead of the one created using the
+	// options above, e.g. to serve a fake from the mocks package.
+	Service mfxkit.Service
+
+	// Logger is used by the logging middleware. Logs are discarded if not
+	// set.
+	Logger log.Logger
+
+	// TLS starts the server using TLS. The SDK trusts its certificate.
+	TLS bool
+}
+
+// Server is a running mfxkit HTTP API server.
+type Server struct {
+	*httptest.Server
+
+	// Service is the served service, wrapped with the middlewares.
+	Service mfxkit.Service
+
+	// Registry collects the metrics of the server.
+	Registry *prometheus.Registry
+
+	// SDK is a client of the server.
+	SDK sdk.SDK
+}
+
+// NewServer starts a server configured by the given options. The caller
+// should call Close when finished, to shut it down.
+func NewServer(opts Options) *Server {
+	if opts.Secret == "" {
+		opts.Secret = DefSecret
+	}
+	if opts.Kits == nil {
+		opts.Kits = memory.NewKitRepository()
+	}
+	if opts.IDProvider == nil {
+		opts.IDProvider = uuid.NewMock()
+	}
+	if opts.Logger == nil {
+		opts.Logger, _ = log.New(ioutil.Discard, log.Error.String())
+	}
+
+	svc := opts.Service
+	if svc == nil {
+		h := hasher.NewBcrypt(bcrypt.MinCost)
+		hash, err := h.Hash(opts.Secret)
+		if err != nil {
+			panic(fmt.Sprintf("mfxkittest: failed to hash secret: %s", err))
+		}
+		svc = mfxkit.New(mfxkit.StaticSecrets{{ID: DefKeyID, Hash: hash}}, h, opts.Auth, opts.Kits, opts.IDProvider, opts.Retention)
+	}
+
+	tracer := opentracing.NoopTracer{}
+	reg := prometheus.NewRegistry()
+
+	svc = api.TracingMiddleware(svc, tracer)
+	svc = api.LoggingMiddleware(svc, opts.Logger)
+	counter, latency, items := api.MakeMetrics(reg)
+	svc = api.MetricsMiddleware(svc, counter, latency, items)
+
+	handler := httpapi.MakeHandler(tracer, svc, reg)
+
+	ts := httptest.NewUnstartedServer(handler)
+	if opts.TLS {
+		ts.StartTLS()
+	} else {
+		ts.Start()
+	}
+
+	conf := sdk.Config{BaseURL: ts.URL}
+	if opts.TLS {
+		conf.TLSConfig = ts.Client().Transport.(*http.Transport).TLSClientConfig
+	}
+
+	return &Server{
+		Server:   ts,
+		Service:  svc,
+		Registry: reg,
+		SDK:      sdk.NewSDK(conf),
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkittest_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/mainflux/mfxkit/mfxkit/mfxkittest"
+	sdk "github.com/mainflux/mfxkit/pkg/sdk/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewServer(t *testing.T) {
+	cases := []struct {
+		desc   string
+		opts   mfxkittest.Options
+		secret string
+		err    error
+	}{
+		{"ping server with default secret", mfxkittest.Options{}, mfxkittest.DefSecret, nil},
+		{"ping server with configured secret", mfxkittest.Options{Secret: "configured"}, "configured", nil},
+		{"ping server with invalid secret", mfxkittest.Options{}, "invalid", sdk.ErrUnauthorized},
+		{"ping TLS server", mfxkittest.Options{TLS: true}, mfxkittest.DefSecret, nil},
+	}
+
+	for _, tc := range cases {
+		ts := mfxkittest.NewServer(tc.opts)
+
+		_, keyID, err := ts.SDK.Ping(context.Background(), tc.secret)
+		ts.Close()
+		assert.True(t, errors.Is(err, tc.err), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+		if tc.err != nil {
+			continue
+		}
+		assert.Equal(t, mfxkittest.DefKeyID, keyID, fmt.Sprintf("%s: expected key ID %s got %s", tc.desc, mfxkittest.DefKeyID, keyID))
+	}
+}
+
+func TestServerMetrics(t *testing.T) {
+	ts := mfxkittest.NewServer(mfxkittest.Options{})
+	defer ts.Close()
+
+	_, _, err := ts.SDK.Ping(context.Background(), mfxkittest.DefSecret)
+	require.Nil(t, err, fmt.Sprintf("ping: unexpected error: %s", err))
+
+	families, err := ts.Registry.Gather()
+	require.Nil(t, err, fmt.Sprintf("gather metrics: unexpected error: %s", err))
+	assert.NotEmpty(t, families, "gather metrics: expected the server metrics to be registered")
+}
@@ -0,0 +1,147 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkittest
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/mainflux/mainflux"
+	"github.com/mainflux/mfxkit/mfxkit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ThingsServer is a running in-process fake of the Mainflux things gRPC
+// service. It identifies the things by the keys it knows, and authorizes
+// them to access the channels they are connected to. It implements no other
+// RPC.
+type ThingsServer struct {
+	// Addr is the address the server listens on.
+	Addr string
+
+	// Client is a client of the server.
+	Client mainflux.ThingsServiceClient
+
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	svc    *thingsService
+}
+
+// NewThingsServer starts and returns a new fake things server on the
+// loopback interface. The keys map the thing keys to the thing IDs. Things
+// are not connected to any channel until Connect is called. The caller
+// should call Close when finished, to shut it down.
+func NewThingsServer(keys map[string]string) *ThingsServer {
+	svc := &thingsService{
+		keys:     make(map[string]string),
+		channels: make(map[string]map[string]bool),
+		calls:    make(map[string]int),
+	}
+	for key, thingID := range keys {
+		svc.keys[key] = thingID
+	}
+
+	server, conn, addr := serveGRPC(func(server *grpc.Server) {
+		mainflux.RegisterThingsServiceServer(server, svc)
+	})
+
+	return &ThingsServer{
+		Addr:   addr,
+		Client: mainflux.NewThingsServiceClient(conn),
+		server: server,
+		conn:   conn,
+		svc:    svc,
+	}
+}
+
+// Connect connects the thing to the channels.
+func (ts *ThingsServer) Connect(thingID string, chanIDs ...string) {
+	ts.svc.mu.Lock()
+	defer ts.svc.mu.Unlock()
+
+	if ts.svc.channels[thingID] == nil {
+		ts.svc.channels[thingID] = make(map[string]bool)
+	}
+	for _, chanID := range chanIDs {
+		ts.svc.channels[thingID][chanID] = true
+	}
+}
+
+// Disconnect disconnects the thing from the channels.
+func (ts *ThingsServer) Disconnect(thingID string, chanIDs ...string) {
+	ts.svc.mu.Lock()
+	defer ts.svc.mu.Unlock()
+
+	for _, chanID := range chanIDs {
+		delete(ts.svc.channels[thingID], chanID)
+	}
+}
+
+// Calls returns the number of calls of the RPC with the given name, e.g.
+// CanAccessByKey, the server handled.
+func (ts *ThingsServer) Calls(method string) int {
+	ts.svc.mu.Lock()
+	defer ts.svc.mu.Unlock()
+
+	return ts.svc.calls[method]
+}
+
+// Close closes the client connection and shuts down the server.
+func (ts *ThingsServer) Close() {
+	ts.conn.Close()
+	ts.server.Stop()
+}
+
+type thingsService struct {
+	mainflux.UnimplementedThingsServiceServer
+
+	mu       sync.Mutex
+	keys     map[string]string
+	channels map[string]map[string]bool
+	calls    map[string]int
+}
+
+func (svc *thingsService) Identify(_ context.Context, key *mainflux.Token) (*mainflux.ThingID, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.calls["Identify"]++
+
+	thingID, ok := svc.keys[key.GetValue()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, mfxkit.ErrNotFound.Error())
+	}
+
+	return &mainflux.ThingID{Value: thingID}, nil
+}
+
+func (svc *thingsService) CanAccessByKey(_ context.Context, req *mainflux.AccessByKeyReq) (*mainflux.ThingID, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.calls["CanAccessByKey"]++
+
+	thingID, ok := svc.keys[req.GetToken()]
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, mfxkit.ErrUnauthorizedAccess.Error())
+	}
+	if !svc.channels[thingID][req.GetChanID()] {
+		return nil, status.Error(codes.PermissionDenied, mfxkit.ErrForbidden.Error())
+	}
+
+	return &mainflux.ThingID{Value: thingID}, nil
+}
+
+func (svc *thingsService) CanAccessByID(_ context.Context, req *mainflux.AccessByIDReq) (*empty.Empty, error) {
+	svc.mu.Lock()
+	defer svc.mu.Unlock()
+	svc.calls["CanAccessByID"]++
+
+	if !svc.channels[req.GetThingID()][req.GetChanID()] {
+		return nil, status.Error(codes.PermissionDenied, mfxkit.ErrForbidden.Error())
+	}
+
+	return &empty.Empty{}, nil
+}
@@ -0,0 +1,16 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mocks contains fakes of the mfxkit service and its dependencies,
+// to be used by the tests of the API and of the services depending on
+// mfxkit. Every fake records its calls and its behaviour can be scripted
+// per method with fixed results, injected errors and latency:
+//
+//	svc := mocks.NewService(map[string]string{"token": "user@example.com"})
+//	svc.Script("ViewKit", mocks.Behaviour{Err: mfxkit.ErrNotFound, Times: 1})
+//	svc.Script("Ping", mocks.Behaviour{Latency: time.Second})
+//
+//	// exercise the code under test
+//
+//	calls := svc.CallsTo("ViewKit")
+package mocks
@@ -0,0 +1,151 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/memory"
+)
+
+var _ mfxkit.KitRepository = (*KitRepository)(nil)
+
+// KitRepository is a mock kit repository. Unless scripted otherwise, it
+// keeps kits in memory the same way the in-memory repository does.
+type KitRepository struct {
+	Recorder
+
+	repo mfxkit.KitRepository
+}
+
+// NewKitRepository creates a mock kit repository.
+func NewKitRepository() *KitRepository {
+	return &KitRepository{
+		repo: memory.NewKitRepository(),
+	}
+}
+
+// Save saves the kits.
+func (krm *KitRepository) Save(ctx context.Context, kits ...mfxkit.Kit) ([]mfxkit.Kit, error) {
+	b, err := krm.record(ctx, "Save", kits)
+	if err != nil {
+		return []mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return []mfxkit.Kit{}, b.Err
+		}
+		return b.Result.([]mfxkit.Kit), nil
+	}
+
+	return krm.repo.Save(ctx, kits...)
+}
+
+// Update updates the kit.
+func (krm *KitRepository) Update(ctx context.Context, kit mfxkit.Kit) (mfxkit.Kit, error) {
+	b, err := krm.record(ctx, "Update", kit)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Kit{}, b.Err
+		}
+		return b.Result.(mfxkit.Kit), nil
+	}
+
+	return krm.repo.Update(ctx, kit)
+}
+
+// RetrieveByID retrieves the kit.
+func (krm *KitRepository) RetrieveByID(ctx context.Context, owner, id string) (mfxkit.Kit, error) {
+	b, err := krm.record(ctx, "RetrieveByID", owner, id)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Kit{}, b.Err
+		}
+		return b.Result.(mfxkit.Kit), nil
+	}
+
+	return krm.repo.RetrieveByID(ctx, owner, id)
+}
+
+// RetrieveAll retrieves the page of kits.
+func (krm *KitRepository) RetrieveAll(ctx context.Context, owner string, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	b, err := krm.record(ctx, "RetrieveAll", owner, pm)
+	if err != nil {
+		return mfxkit.Page{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Page{}, b.Err
+		}
+		return b.Result.(mfxkit.Page), nil
+	}
+
+	return krm.repo.RetrieveAll(ctx, owner, pm)
+}
+
+// Remove removes the kit.
+func (krm *KitRepository) Remove(ctx context.Context, owner, id string, rev uint64, at time.Time) error {
+	b, err := krm.record(ctx, "Remove", owner, id, rev, at)
+	if err != nil {
+		return err
+	}
+	if b.scripted() {
+		return b.Err
+	}
+
+	return krm.repo.Remove(ctx, owner, id, rev, at)
+}
+
+// BulkRemove removes the kits.
+func (krm *KitRepository) BulkRemove(ctx context.Context, owner string, at time.Time, kits ...mfxkit.Kit) error {
+	b, err := krm.record(ctx, "BulkRemove", owner, at, kits)
+	if err != nil {
+		return err
+	}
+	if b.scripted() {
+		return b.Err
+	}
+
+	return krm.repo.BulkRemove(ctx, owner, at, kits...)
+}
+
+// Restore restores the removed kit.
+func (krm *KitRepository) Restore(ctx context.Context, owner, id string, since time.Time) (mfxkit.Kit, error) {
+	b, err := krm.record(ctx, "Restore", owner, id, since)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Kit{}, b.Err
+		}
+		return b.Result.(mfxkit.Kit), nil
+	}
+
+	return krm.repo.Restore(ctx, owner, id, since)
+}
+
+// Purge permanently deletes the kits removed before the given time.
+func (krm *KitRepository) Purge(ctx context.Context, before time.Time) (uint64, error) {
+	b, err := krm.record(ctx, "Purge", before)
+	if err != nil {
+		return 0, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return 0, b.Err
+		}
+		return b.Result.(uint64), nil
+	}
+
+	return krm.repo.Purge(ctx, before)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Behaviour scripts the outcome of the calls of a single method. A call
+// first waits for the latency, or until its context is done. Then it fails
+// with the error, if set, or returns the result, if set. A behaviour without
+// both of them keeps the default behaviour of the fake.
+type Behaviour struct {
+	// Result is returned instead of the default result. It must have the
+	// type of the first method result, e.g. mfxkit.Kit for ViewKit.
+	Result interface{}
+
+	// Err is returned instead of the default error.
+	Err error
+
+	// Latency delays the call.
+	Latency time.Duration
+
+	// Times limits the number of calls the behaviour applies to. Zero
+	// means that it applies to all of them.
+	Times int
+}
+
+// Call contains the method and the arguments of a recorded call, without
+// the context.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// Recorder records calls and holds the scripted behaviour of a fake. The
+// zero value is ready to use.
+type Recorder struct {
+	mu     sync.Mutex
+	calls  []Call
+	script map[string][]Behaviour
+}
+
+// Script appends the behaviour of the method calls. Behaviours of the same
+// method apply in the order they are scripted, each one for its number of
+// calls.
+func (r *Recorder) Script(method string, b Behaviour) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.script == nil {
+		r.script = make(map[string][]Behaviour)
+	}
+	r.script[method] = append(r.script[method], b)
+}
+
+// Calls returns all the recorded calls in the order they were made.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call{}, r.calls...)
+}
+
+// CallsTo returns the recorded calls of the method in the order they were
+// made.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	calls := []Call{}
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// Reset removes the recorded calls and the scripted behaviours.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+	r.script = nil
+}
+
+// record records the call and applies the scripted latency. It returns the
+// scripted behaviour, or the context error if the context is done while
+// waiting.
+func (r *Recorder) record(ctx context.Context, method string, args ...interface{}) (Behaviour, error) {
+	b := r.next(method, args)
+	if b.Latency == 0 {
+		return b, nil
+	}
+
+	t := time.NewTimer(b.Latency)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return Behaviour{}, ctx.Err()
+	case <-t.C:
+		return b, nil
+	}
+}
+
+func (r *Recorder) next(method string, args []interface{}) Behaviour {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+
+	bs := r.script[method]
+	if len(bs) == 0 {
+		return Behaviour{}
+	}
+
+	b := bs[0]
+	if b.Times > 0 {
+		bs[0].Times--
+		if bs[0].Times == 0 {
+			r.script[method] = bs[1:]
+		}
+	}
+
+	return b
+}
+
+// scripted reports whether the behaviour replaces the default one.
+func (b Behaviour) scripted() bool {
+	return b.Err != nil || b.Result != nil
+}
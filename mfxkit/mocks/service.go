@@ -0,0 +1,320 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mocks
+
+import (
+	"context"
+	"time"
+
+	"github.com/mainflux/mainflux"
+	"github.com/mainflux/mainflux/pkg/uuid"
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+var _ mfxkit.Service = (*Service)(nil)
+
+// Service is an in-memory fake of the mfxkit service. Unless scripted
+// otherwise, it identifies callers using the tokens it was created with and
+// keeps kits in a mock repository, without paging validation, retention or
+// any other domain rule.
+type Service struct {
+	Recorder
+
+	tokens     map[string]string
+	kits       mfxkit.KitRepository
+	idProvider mainflux.IDProvider
+}
+
+// NewService creates a fake mfxkit service. The tokens map the tokens and
+// secrets accepted by the service to the identities of their owners.
+func NewService(tokens map[string]string) *Service {
+	return &Service{
+		tokens:     tokens,
+		kits:       NewKitRepository(),
+		idProvider: uuid.NewMock(),
+	}
+}
+
+// Ping returns the greeting to the callers using one of the known tokens.
+// The identity of the token owner is reported as the key ID. A scripted
+// result is returned as the greeting with an empty key ID.
+func (svc *Service) Ping(ctx context.Context, secret string) (string, string, error) {
+	b, err := svc.record(ctx, "Ping", secret)
+	if err != nil {
+		return "", "", err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return "", "", b.Err
+		}
+		return b.Result.(string), "", nil
+	}
+
+	keyID, err := svc.identify(ctx, secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return "Hello World :)", keyID, nil
+}
+
+// CreateKits saves the kits to the mock repository.
+func (svc *Service) CreateKits(ctx context.Context, token string, kits ...mfxkit.Kit) ([]mfxkit.Kit, error) {
+	b, err := svc.record(ctx, "CreateKits", token, kits)
+	if err != nil {
+		return []mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return []mfxkit.Kit{}, b.Err
+		}
+		return b.Result.([]mfxkit.Kit), nil
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return []mfxkit.Kit{}, err
+	}
+
+	if err := svc.prepare(owner, kits); err != nil {
+		return []mfxkit.Kit{}, err
+	}
+
+	return svc.kits.Save(ctx, kits...)
+}
+
+// BulkCreateKits saves the kits to the mock repository and reports the
+// outcome of each of them.
+func (svc *Service) BulkCreateKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) ([]mfxkit.BulkResult, error) {
+	b, err := svc.record(ctx, "BulkCreateKits", token, atomic, kits)
+	if err != nil {
+		return []mfxkit.BulkResult{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return []mfxkit.BulkResult{}, b.Err
+		}
+		return b.Result.([]mfxkit.BulkResult), nil
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return []mfxkit.BulkResult{}, err
+	}
+
+	if err := svc.prepare(owner, kits); err != nil {
+		return []mfxkit.BulkResult{}, err
+	}
+
+	if atomic {
+		if _, err := svc.kits.Save(ctx, kits...); err != nil {
+			return mfxkit.AbortedResults(kits, err), nil
+		}
+	}
+
+	res := make([]mfxkit.BulkResult, len(kits))
+	for i, kit := range kits {
+		res[i] = mfxkit.BulkResult{Kit: kit}
+		if !atomic {
+			_, res[i].Err = svc.kits.Save(ctx, kit)
+		}
+	}
+
+	return res, nil
+}
+
+// ViewKit retrieves the kit from the mock repository.
+func (svc *Service) ViewKit(ctx context.Context, token, id string) (mfxkit.Kit, error) {
+	b, err := svc.record(ctx, "ViewKit", token, id)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Kit{}, b.Err
+		}
+		return b.Result.(mfxkit.Kit), nil
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return svc.kits.RetrieveByID(ctx, owner, id)
+}
+
+// UpdateKit updates the kit in the mock repository.
+func (svc *Service) UpdateKit(ctx context.Context, token string, kit mfxkit.Kit) (mfxkit.Kit, error) {
+	b, err := svc.record(ctx, "UpdateKit", token, kit)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Kit{}, b.Err
+		}
+		return b.Result.(mfxkit.Kit), nil
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	kit.Owner = owner
+	kit.UpdatedAt = time.Now().UTC()
+
+	return svc.kits.Update(ctx, kit)
+}
+
+// ListKits retrieves the page of kits from the mock repository.
+func (svc *Service) ListKits(ctx context.Context, token string, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	b, err := svc.record(ctx, "ListKits", token, pm)
+	if err != nil {
+		return mfxkit.Page{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Page{}, b.Err
+		}
+		return b.Result.(mfxkit.Page), nil
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return mfxkit.Page{}, err
+	}
+
+	return svc.kits.RetrieveAll(ctx, owner, pm.Normalize())
+}
+
+// RemoveKit removes the kit from the mock repository.
+func (svc *Service) RemoveKit(ctx context.Context, token, id string, rev uint64) error {
+	b, err := svc.record(ctx, "RemoveKit", token, id, rev)
+	if err != nil {
+		return err
+	}
+	if b.scripted() {
+		return b.Err
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	return svc.kits.Remove(ctx, owner, id, rev, time.Now().UTC())
+}
+
+// BulkRemoveKits removes the kits from the mock repository and reports the
+// outcome of each of them.
+func (svc *Service) BulkRemoveKits(ctx context.Context, token string, atomic bool, kits ...mfxkit.Kit) ([]mfxkit.BulkResult, error) {
+	b, err := svc.record(ctx, "BulkRemoveKits", token, atomic, kits)
+	if err != nil {
+		return []mfxkit.BulkResult{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return []mfxkit.BulkResult{}, b.Err
+		}
+		return b.Result.([]mfxkit.BulkResult), nil
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return []mfxkit.BulkResult{}, err
+	}
+
+	now := time.Now().UTC()
+	if atomic {
+		if err := svc.kits.BulkRemove(ctx, owner, now, kits...); err != nil {
+			return mfxkit.AbortedResults(kits, err), nil
+		}
+	}
+
+	res := make([]mfxkit.BulkResult, len(kits))
+	for i, kit := range kits {
+		res[i] = mfxkit.BulkResult{Kit: kit}
+		if !atomic {
+			res[i].Err = svc.kits.Remove(ctx, owner, kit.ID, kit.Revision, now)
+		}
+	}
+
+	return res, nil
+}
+
+// RestoreKit restores the removed kit in the mock repository, regardless of
+// the time of removal.
+func (svc *Service) RestoreKit(ctx context.Context, token, id string) (mfxkit.Kit, error) {
+	b, err := svc.record(ctx, "RestoreKit", token, id)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return mfxkit.Kit{}, b.Err
+		}
+		return b.Result.(mfxkit.Kit), nil
+	}
+
+	owner, err := svc.identify(ctx, token)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return svc.kits.Restore(ctx, owner, id, time.Time{})
+}
+
+// PurgeKits permanently deletes all the removed kits from the mock
+// repository, if the secret is one of the known tokens.
+func (svc *Service) PurgeKits(ctx context.Context, secret string) (uint64, error) {
+	b, err := svc.record(ctx, "PurgeKits", secret)
+	if err != nil {
+		return 0, err
+	}
+	if b.scripted() {
+		if b.Err != nil {
+			return 0, b.Err
+		}
+		return b.Result.(uint64), nil
+	}
+
+	if _, err := svc.identify(ctx, secret); err != nil {
+		return 0, err
+	}
+
+	return svc.kits.Purge(ctx, time.Now().UTC())
+}
+
+func (svc *Service) prepare(owner string, kits []mfxkit.Kit) error {
+	now := time.Now().UTC()
+	for i := range kits {
+		id, err := svc.idProvider.ID()
+		if err != nil {
+			return err
+		}
+
+		kits[i].ID = id
+		kits[i].Owner = owner
+		kits[i].Revision = 1
+		kits[i].CreatedAt = now
+		kits[i].UpdatedAt = now
+	}
+
+	return nil
+}
+
+func (svc *Service) identify(ctx context.Context, token string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	owner, ok := svc.tokens[token]
+	if !ok {
+		return "", mfxkit.ErrUnauthorizedAccess
+	}
+
+	return owner, nil
+}
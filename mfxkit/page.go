@@ -0,0 +1,192 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	// DefLimit is the page size used when none is requested.
+	DefLimit = 10
+
+	// MaxLimit is the maximum page size a caller can request.
+	MaxLimit = 100
+
+	// OrderByID orders pages by entity identifier.
+	OrderByID = "id"
+
+	// OrderByName orders pages by entity name.
+	OrderByName = "name"
+
+	// AscDir sorts pages in ascending order.
+	AscDir = "asc"
+
+	// DescDir sorts pages in descending order.
+	DescDir = "desc"
+)
+
+// PageMetadata contains page metadata that helps navigation.
+type PageMetadata struct {
+	Total    uint64
+	Offset   uint64
+	Limit    uint64
+	Order    string
+	Dir      string
+	Name     string
+	Metadata Metadata
+}
+
+// Page contains page related metadata as well as list of kits that
+// belong to this page.
+type Page struct {
+	PageMetadata
+	Kits []Kit
+}
+
+// Normalize replaces missing page metadata values with their defaults, so
+// that every repository pages entities the same way.
+func (pm PageMetadata) Normalize() PageMetadata {
+	if pm.Limit == 0 {
+		pm.Limit = DefLimit
+	}
+
+	if pm.Order == "" {
+		pm.Order = OrderByID
+	}
+
+	if pm.Dir == "" {
+		pm.Dir = AscDir
+	}
+
+	return pm
+}
+
+// Validate returns ErrMalformedEntity if page metadata exceeds the limit
+// size or requests an unsupported order or direction.
+func (pm PageMetadata) Validate() error {
+	if pm.Limit > MaxLimit {
+		return ErrMalformedEntity
+	}
+
+	switch pm.Order {
+	case "", OrderByID, OrderByName:
+	default:
+		return ErrMalformedEntity
+	}
+
+	switch pm.Dir {
+	case "", AscDir, DescDir:
+	default:
+		return ErrMalformedEntity
+	}
+
+	return nil
+}
+
+// Apply filters, sorts and pages the given kits, and returns the page with
+// the total number of kits that satisfy the filters. It is meant for
+// repositories that are not able to page kits natively.
+func (pm PageMetadata) Apply(kits []Kit) Page {
+	matched := []Kit{}
+	for _, kit := range kits {
+		if pm.Matches(kit) {
+			matched = append(matched, kit)
+		}
+	}
+	pm.sort(matched)
+
+	page := Page{
+		PageMetadata: pm,
+		Kits:         []Kit{},
+	}
+	page.Total = uint64(len(matched))
+
+	if pm.Offset >= page.Total {
+		return page
+	}
+
+	end := pm.Offset + pm.Limit
+	if end > page.Total || end < pm.Offset {
+		end = page.Total
+	}
+	page.Kits = matched[pm.Offset:end]
+
+	return page
+}
+
+// sort orders kits by the page order and direction. Kits with equal names
+// are ordered by their identifiers.
+func (pm PageMetadata) sort(kits []Kit) {
+	less := func(i, j int) bool {
+		return kits[i].ID < kits[j].ID
+	}
+	if pm.Order == OrderByName {
+		less = func(i, j int) bool {
+			if kits[i].Name == kits[j].Name {
+				return kits[i].ID < kits[j].ID
+			}
+			return kits[i].Name < kits[j].Name
+		}
+	}
+
+	if pm.Dir == DescDir {
+		sort.SliceStable(kits, func(i, j int) bool {
+			return less(j, i)
+		})
+		return
+	}
+	sort.SliceStable(kits, less)
+}
+
+// Matches reports whether the kit satisfies page filters: its name contains
+// the requested name, ignoring case, and its metadata contains all of the
+// requested metadata.
+func (pm PageMetadata) Matches(kit Kit) bool {
+	if pm.Name != "" && !strings.Contains(strings.ToLower(kit.Name), strings.ToLower(pm.Name)) {
+		return false
+	}
+
+	return contains(kit.Metadata, pm.Metadata)
+}
+
+// contains reports whether every key of sub is present in md with an equal
+// value. Nested objects are compared recursively, the same way JSONB
+// containment works.
+func contains(md, sub map[string]interface{}) bool {
+	for k, v := range sub {
+		val, ok := md[k]
+		if !ok {
+			return false
+		}
+
+		subMap, ok := asMap(v)
+		if !ok {
+			if !reflect.DeepEqual(val, v) {
+				return false
+			}
+			continue
+		}
+
+		valMap, ok := asMap(val)
+		if !ok || !contains(valMap, subMap) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func asMap(v interface{}) (map[string]interface{}, bool) {
+	switch m := v.(type) {
+	case map[string]interface{}:
+		return m, true
+	case Metadata:
+		return m, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package repotest contains the contract test suite that every kit
+// repository implementation must pass. A repository package runs it from
+// its own tests, providing a constructor of empty repositories:
+//
+//	func TestKitRepository(t *testing.T) {
+//		repotest.TestKitRepository(t, func(t *testing.T) mfxkit.KitRepository {
+//			return memory.NewKitRepository()
+//		})
+//	}
+package repotest
@@ -0,0 +1,611 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package repotest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	owner      = "owner@example.com"
+	otherOwner = "other@example.com"
+	numKits    = 12
+	workers    = 10
+)
+
+// Factory returns an empty kit repository. It is called once per test, so
+// that tests do not share state.
+type Factory func(t *testing.T) mfxkit.KitRepository
+
+// TestKitRepository runs the kit repository contract tests against the
+// repositories returned by the factory.
+func TestKitRepository(t *testing.T, newRepo Factory) {
+	tests := []struct {
+		name string
+		test func(*testing.T, mfxkit.KitRepository)
+	}{
+		{"save", testSave},
+		{"retrieve by ID", testRetrieveByID},
+		{"update", testUpdate},
+		{"retrieve all paging", testRetrieveAllPaging},
+		{"retrieve all filters", testRetrieveAllFilters},
+		{"remove", testRemove},
+		{"bulk remove", testBulkRemove},
+		{"restore", testRestore},
+		{"purge", testPurge},
+		{"concurrent update", testConcurrentUpdate},
+		{"concurrent save", testConcurrentSave},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.test(t, newRepo(t))
+		})
+	}
+}
+
+func testSave(t *testing.T, repo mfxkit.KitRepository) {
+	kit := newKit(owner, 1)
+	saved, err := repo.Save(context.Background(), kit)
+	require.Nil(t, err, fmt.Sprintf("save kit: unexpected error: %s", err))
+	require.Len(t, saved, 1, "save kit: expected one saved kit")
+	assertKit(t, kit, saved[0], "save kit")
+
+	_, err = repo.Save(context.Background(), newKit(owner, 2), newKit(owner, 3))
+	assert.Nil(t, err, fmt.Sprintf("save multiple kits: unexpected error: %s", err))
+
+	page, err := repo.RetrieveAll(context.Background(), owner, pageMetadata())
+	require.Nil(t, err, fmt.Sprintf("retrieve all: unexpected error: %s", err))
+	assert.Equal(t, uint64(3), page.Total, "save kits: expected all kits to be saved")
+}
+
+func testRetrieveByID(t *testing.T, repo mfxkit.KitRepository) {
+	kit := newKit(owner, 1)
+	save(t, repo, kit)
+
+	cases := []struct {
+		desc  string
+		owner string
+		id    string
+		err   error
+	}{
+		{
+			desc:  "retrieve existing kit",
+			owner: owner,
+			id:    kit.ID,
+			err:   nil,
+		},
+		{
+			desc:  "retrieve kit of another owner",
+			owner: otherOwner,
+			id:    kit.ID,
+			err:   mfxkit.ErrNotFound,
+		},
+		{
+			desc:  "retrieve non-existent kit",
+			owner: owner,
+			id:    kitID(numKits + 1),
+			err:   mfxkit.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		k, err := repo.RetrieveByID(context.Background(), tc.owner, tc.id)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		if err == nil {
+			assertKit(t, kit, k, tc.desc)
+		}
+	}
+}
+
+func testUpdate(t *testing.T, repo mfxkit.KitRepository) {
+	kit := newKit(owner, 1)
+	save(t, repo, kit)
+
+	update := func(k mfxkit.Kit, name string, rev uint64) mfxkit.Kit {
+		k.Name = name
+		k.Metadata = mfxkit.Metadata{"updated": name}
+		k.Revision = rev
+		k.UpdatedAt = k.UpdatedAt.Add(time.Duration(rev) * time.Second)
+		return k
+	}
+
+	cases := []struct {
+		desc    string
+		kit     mfxkit.Kit
+		err     error
+		revison uint64
+	}{
+		{
+			desc:    "update kit with current revision",
+			kit:     update(kit, "first", 1),
+			err:     nil,
+			revison: 2,
+		},
+		{
+			desc: "update kit with stale revision",
+			kit:  update(kit, "stale", 1),
+			err:  mfxkit.ErrConflict,
+		},
+		{
+			desc: "update kit with future revision",
+			kit:  update(kit, "future", 5),
+			err:  mfxkit.ErrConflict,
+		},
+		{
+			desc:    "update kit without revision",
+			kit:     update(kit, "second", 0),
+			err:     nil,
+			revison: 3,
+		},
+		{
+			desc: "update kit of another owner",
+			kit: func() mfxkit.Kit {
+				k := update(kit, "other", 0)
+				k.Owner = otherOwner
+				return k
+			}(),
+			err: mfxkit.ErrNotFound,
+		},
+		{
+			desc: "update non-existent kit",
+			kit:  update(newKit(owner, numKits+1), "missing", 0),
+			err:  mfxkit.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		updated, err := repo.Update(context.Background(), tc.kit)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+		if err != nil {
+			continue
+		}
+
+		expected := tc.kit
+		expected.Revision = tc.revison
+		assertKit(t, expected, updated, tc.desc)
+
+		stored, err := repo.RetrieveByID(context.Background(), owner, kit.ID)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assertKit(t, expected, stored, tc.desc)
+	}
+}
+
+func testRetrieveAllPaging(t *testing.T, repo mfxkit.KitRepository) {
+	kits := []mfxkit.Kit{}
+	for i := 1; i <= numKits; i++ {
+		kit := newKit(owner, i)
+		// Names are ordered opposite to identifiers.
+		kit.Name = fmt.Sprintf("name-%03d", numKits-i)
+		kits = append(kits, kit)
+	}
+	save(t, repo, kits...)
+	save(t, repo, newKit(otherOwner, numKits+1))
+
+	reversed := make([]mfxkit.Kit, len(kits))
+	for i, kit := range kits {
+		reversed[len(kits)-1-i] = kit
+	}
+
+	cases := []struct {
+		desc     string
+		pm       mfxkit.PageMetadata
+		expected []mfxkit.Kit
+	}{
+		{
+			desc:     "retrieve first page",
+			pm:       mfxkit.PageMetadata{Offset: 0, Limit: 5},
+			expected: kits[:5],
+		},
+		{
+			desc:     "retrieve middle page",
+			pm:       mfxkit.PageMetadata{Offset: 5, Limit: 5},
+			expected: kits[5:10],
+		},
+		{
+			desc:     "retrieve last incomplete page",
+			pm:       mfxkit.PageMetadata{Offset: 10, Limit: 5},
+			expected: kits[10:],
+		},
+		{
+			desc:     "retrieve page past the last kit",
+			pm:       mfxkit.PageMetadata{Offset: numKits, Limit: 5},
+			expected: []mfxkit.Kit{},
+		},
+		{
+			desc:     "retrieve page ordered by ID descending",
+			pm:       mfxkit.PageMetadata{Offset: 0, Limit: 3, Order: mfxkit.OrderByID, Dir: mfxkit.DescDir},
+			expected: reversed[:3],
+		},
+		{
+			desc:     "retrieve page ordered by name ascending",
+			pm:       mfxkit.PageMetadata{Offset: 0, Limit: 3, Order: mfxkit.OrderByName, Dir: mfxkit.AscDir},
+			expected: reversed[:3],
+		},
+		{
+			desc:     "retrieve page ordered by name descending",
+			pm:       mfxkit.PageMetadata{Offset: 2, Limit: 3, Order: mfxkit.OrderByName, Dir: mfxkit.DescDir},
+			expected: kits[2:5],
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveAll(context.Background(), owner, tc.pm.Normalize())
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assert.Equal(t, uint64(numKits), page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, numKits, page.Total))
+		assertKits(t, tc.expected, page.Kits, tc.desc)
+	}
+}
+
+func testRetrieveAllFilters(t *testing.T, repo mfxkit.KitRepository) {
+	gateway := newKit(owner, 1)
+	gateway.Name = "Edge_Gateway"
+	gateway.Metadata = mfxkit.Metadata{
+		"type":     "gateway",
+		"location": map[string]interface{}{"city": "Belgrade", "floor": float64(2)},
+	}
+
+	sensor := newKit(owner, 2)
+	sensor.Name = "sensor"
+	sensor.Metadata = mfxkit.Metadata{
+		"type":     "sensor",
+		"location": map[string]interface{}{"city": "Belgrade", "floor": float64(3)},
+	}
+
+	plain := newKit(owner, 3)
+	plain.Name = "EdgeXGateway"
+	plain.Metadata = nil
+
+	save(t, repo, gateway, sensor, plain)
+
+	cases := []struct {
+		desc     string
+		pm       mfxkit.PageMetadata
+		expected []mfxkit.Kit
+	}{
+		{
+			desc:     "filter by name ignoring case",
+			pm:       mfxkit.PageMetadata{Name: "edge"},
+			expected: []mfxkit.Kit{gateway, plain},
+		},
+		{
+			desc:     "filter by name with wildcard characters",
+			pm:       mfxkit.PageMetadata{Name: "_gate"},
+			expected: []mfxkit.Kit{gateway},
+		},
+		{
+			desc:     "filter by metadata",
+			pm:       mfxkit.PageMetadata{Metadata: mfxkit.Metadata{"type": "sensor"}},
+			expected: []mfxkit.Kit{sensor},
+		},
+		{
+			desc:     "filter by nested metadata",
+			pm:       mfxkit.PageMetadata{Metadata: mfxkit.Metadata{"location": map[string]interface{}{"city": "Belgrade"}}},
+			expected: []mfxkit.Kit{gateway, sensor},
+		},
+		{
+			desc:     "filter by name and metadata",
+			pm:       mfxkit.PageMetadata{Name: "gateway", Metadata: mfxkit.Metadata{"type": "gateway"}},
+			expected: []mfxkit.Kit{gateway},
+		},
+		{
+			desc:     "filter by metadata with paging",
+			pm:       mfxkit.PageMetadata{Offset: 1, Limit: 1, Metadata: mfxkit.Metadata{"location": map[string]interface{}{"city": "Belgrade"}}},
+			expected: []mfxkit.Kit{sensor},
+		},
+		{
+			desc:     "filter by non-matching metadata",
+			pm:       mfxkit.PageMetadata{Metadata: mfxkit.Metadata{"type": "actuator"}},
+			expected: []mfxkit.Kit{},
+		},
+	}
+
+	for _, tc := range cases {
+		page, err := repo.RetrieveAll(context.Background(), owner, tc.pm.Normalize())
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		assertKits(t, tc.expected, page.Kits, tc.desc)
+		if tc.pm.Offset == 0 {
+			assert.Equal(t, uint64(len(tc.expected)), page.Total, fmt.Sprintf("%s: expected total %d got %d", tc.desc, len(tc.expected), page.Total))
+		}
+	}
+}
+
+func testRemove(t *testing.T, repo mfxkit.KitRepository) {
+	kit := newKit(owner, 1)
+	other := newKit(owner, 2)
+	save(t, repo, kit, other)
+	now := time.Now().UTC()
+
+	cases := []struct {
+		desc  string
+		owner string
+		id    string
+		rev   uint64
+		err   error
+	}{
+		{
+			desc:  "remove kit of another owner",
+			owner: otherOwner,
+			id:    kit.ID,
+			err:   nil,
+		},
+		{
+			desc:  "remove kit with stale revision",
+			owner: owner,
+			id:    kit.ID,
+			rev:   2,
+			err:   mfxkit.ErrConflict,
+		},
+		{
+			desc:  "remove kit with current revision",
+			owner: owner,
+			id:    kit.ID,
+			rev:   1,
+			err:   nil,
+		},
+		{
+			desc:  "remove removed kit",
+			owner: owner,
+			id:    kit.ID,
+			rev:   1,
+			err:   nil,
+		},
+		{
+			desc:  "remove non-existent kit",
+			owner: owner,
+			id:    kitID(numKits + 1),
+			err:   nil,
+		},
+	}
+
+	for _, tc := range cases {
+		err := repo.Remove(context.Background(), tc.owner, tc.id, tc.rev, now)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+	}
+
+	_, err := repo.RetrieveByID(context.Background(), owner, kit.ID)
+	assert.Equal(t, mfxkit.ErrNotFound, err, fmt.Sprintf("retrieve removed kit: expected %s got %s", mfxkit.ErrNotFound, err))
+
+	_, err = repo.Update(context.Background(), kit)
+	assert.Equal(t, mfxkit.ErrNotFound, err, fmt.Sprintf("update removed kit: expected %s got %s", mfxkit.ErrNotFound, err))
+
+	page, err := repo.RetrieveAll(context.Background(), owner, pageMetadata())
+	require.Nil(t, err, fmt.Sprintf("retrieve all: unexpected error: %s", err))
+	assertKits(t, []mfxkit.Kit{other}, page.Kits, "retrieve all after removal")
+	assert.Equal(t, uint64(1), page.Total, "retrieve all after removal: expected removed kit not to be counted")
+}
+
+func testBulkRemove(t *testing.T, repo mfxkit.KitRepository) {
+	first := newKit(owner, 1)
+	second := newKit(owner, 2)
+	save(t, repo, first, second)
+	now := time.Now().UTC()
+
+	stale := second
+	stale.Revision = 2
+	err := repo.BulkRemove(context.Background(), owner, now, first, stale)
+	ie, ok := err.(*mfxkit.ItemError)
+	require.True(t, ok, fmt.Sprintf("bulk remove with stale revision: expected item error got %s", err))
+	assert.Equal(t, 1, ie.Index, fmt.Sprintf("bulk remove with stale revision: expected failed item 1 got %d", ie.Index))
+	assert.Equal(t, mfxkit.ErrConflict, ie.Err, fmt.Sprintf("bulk remove with stale revision: expected %s got %s", mfxkit.ErrConflict, ie.Err))
+
+	_, err = repo.RetrieveByID(context.Background(), owner, first.ID)
+	assert.Nil(t, err, fmt.Sprintf("bulk remove with stale revision: expected kits to be kept got %s", err))
+
+	err = repo.BulkRemove(context.Background(), owner, now, first, second, newKit(owner, numKits+1))
+	assert.Nil(t, err, fmt.Sprintf("bulk remove: unexpected error: %s", err))
+
+	page, err := repo.RetrieveAll(context.Background(), owner, pageMetadata())
+	require.Nil(t, err, fmt.Sprintf("retrieve all: unexpected error: %s", err))
+	assert.Equal(t, uint64(0), page.Total, "bulk remove: expected all kits to be removed")
+}
+
+func testRestore(t *testing.T, repo mfxkit.KitRepository) {
+	recent := newKit(owner, 1)
+	expired := newKit(owner, 2)
+	live := newKit(owner, 3)
+	save(t, repo, recent, expired, live)
+
+	now := time.Now().UTC()
+	since := now.Add(-time.Hour)
+	remove(t, repo, recent, now.Add(-time.Minute))
+	remove(t, repo, expired, now.Add(-2*time.Hour))
+
+	cases := []struct {
+		desc  string
+		owner string
+		id    string
+		err   error
+	}{
+		{
+			desc:  "restore kit of another owner",
+			owner: otherOwner,
+			id:    recent.ID,
+			err:   mfxkit.ErrNotFound,
+		},
+		{
+			desc:  "restore kit removed within retention period",
+			owner: owner,
+			id:    recent.ID,
+			err:   nil,
+		},
+		{
+			desc:  "restore restored kit",
+			owner: owner,
+			id:    recent.ID,
+			err:   mfxkit.ErrNotFound,
+		},
+		{
+			desc:  "restore kit removed before retention period",
+			owner: owner,
+			id:    expired.ID,
+			err:   mfxkit.ErrNotFound,
+		},
+		{
+			desc:  "restore kit that is not removed",
+			owner: owner,
+			id:    live.ID,
+			err:   mfxkit.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		_, err := repo.Restore(context.Background(), tc.owner, tc.id, since)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %s got %s", tc.desc, tc.err, err))
+	}
+
+	kit, err := repo.RetrieveByID(context.Background(), owner, recent.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve restored kit: unexpected error: %s", err))
+	assert.Equal(t, uint64(3), kit.Revision, "retrieve restored kit: expected removal and restoration to increment revision")
+}
+
+func testPurge(t *testing.T, repo mfxkit.KitRepository) {
+	recent := newKit(owner, 1)
+	expired := newKit(owner, 2)
+	otherExpired := newKit(otherOwner, 3)
+	live := newKit(owner, 4)
+	save(t, repo, recent, expired, otherExpired, live)
+
+	now := time.Now().UTC()
+	remove(t, repo, recent, now.Add(-time.Minute))
+	remove(t, repo, expired, now.Add(-2*time.Hour))
+	remove(t, repo, otherExpired, now.Add(-2*time.Hour))
+
+	n, err := repo.Purge(context.Background(), now.Add(-time.Hour))
+	require.Nil(t, err, fmt.Sprintf("purge: unexpected error: %s", err))
+	assert.Equal(t, uint64(2), n, fmt.Sprintf("purge: expected 2 purged kits got %d", n))
+
+	_, err = repo.Restore(context.Background(), owner, recent.ID, now.Add(-time.Hour))
+	assert.Nil(t, err, fmt.Sprintf("restore kit removed after purge time: unexpected error: %s", err))
+
+	_, err = repo.Restore(context.Background(), owner, expired.ID, time.Time{})
+	assert.Equal(t, mfxkit.ErrNotFound, err, fmt.Sprintf("restore purged kit: expected %s got %s", mfxkit.ErrNotFound, err))
+
+	n, err = repo.Purge(context.Background(), now)
+	require.Nil(t, err, fmt.Sprintf("purge: unexpected error: %s", err))
+	assert.Equal(t, uint64(0), n, fmt.Sprintf("purge without removed kits: expected 0 purged kits got %d", n))
+}
+
+func testConcurrentUpdate(t *testing.T, repo mfxkit.KitRepository) {
+	kit := newKit(owner, 1)
+	save(t, repo, kit)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			k := kit
+			k.Name = fmt.Sprintf("worker-%d", i)
+			_, err := repo.Update(context.Background(), k)
+			errs <- err
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	succeeded := 0
+	for err := range errs {
+		switch err {
+		case nil:
+			succeeded++
+		case mfxkit.ErrConflict:
+		default:
+			t.Errorf("concurrent update: unexpected error: %s", err)
+		}
+	}
+	assert.Equal(t, 1, succeeded, fmt.Sprintf("concurrent update: expected exactly one update to succeed got %d", succeeded))
+
+	stored, err := repo.RetrieveByID(context.Background(), owner, kit.ID)
+	require.Nil(t, err, fmt.Sprintf("retrieve kit: unexpected error: %s", err))
+	assert.Equal(t, uint64(2), stored.Revision, fmt.Sprintf("concurrent update: expected revision 2 got %d", stored.Revision))
+}
+
+func testConcurrentSave(t *testing.T, repo mfxkit.KitRepository) {
+	var wg sync.WaitGroup
+	for i := 1; i <= workers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			_, err := repo.Save(context.Background(), newKit(owner, i))
+			assert.Nil(t, err, fmt.Sprintf("concurrent save: unexpected error: %s", err))
+		}(i)
+	}
+	wg.Wait()
+
+	page, err := repo.RetrieveAll(context.Background(), owner, pageMetadata())
+	require.Nil(t, err, fmt.Sprintf("retrieve all: unexpected error: %s", err))
+	assert.Equal(t, uint64(workers), page.Total, fmt.Sprintf("concurrent save: expected %d kits got %d", workers, page.Total))
+}
+
+func pageMetadata() mfxkit.PageMetadata {
+	return mfxkit.PageMetadata{Limit: mfxkit.MaxLimit}.Normalize()
+}
+
+func kitID(i int) string {
+	return fmt.Sprintf("kit-%03d", i)
+}
+
+// newKit returns a kit as prepared by the service. Timestamps are truncated
+// to microseconds, which is the precision supported by every repository.
+func newKit(owner string, i int) mfxkit.Kit {
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	return mfxkit.Kit{
+		ID:        kitID(i),
+		Owner:     owner,
+		Name:      fmt.Sprintf("kit-%d", i),
+		Metadata:  mfxkit.Metadata{"index": float64(i)},
+		Revision:  1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func save(t *testing.T, repo mfxkit.KitRepository, kits ...mfxkit.Kit) {
+	_, err := repo.Save(context.Background(), kits...)
+	require.Nil(t, err, fmt.Sprintf("save kits: unexpected error: %s", err))
+}
+
+func remove(t *testing.T, repo mfxkit.KitRepository, kit mfxkit.Kit, at time.Time) {
+	err := repo.Remove(context.Background(), kit.Owner, kit.ID, 0, at)
+	require.Nil(t, err, fmt.Sprintf("remove kit: unexpected error: %s", err))
+}
+
+func assertKits(t *testing.T, expected, actual []mfxkit.Kit, desc string) {
+	ids := func(kits []mfxkit.Kit) []string {
+		res := []string{}
+		for _, k := range kits {
+			res = append(res, k.ID)
+		}
+		return res
+	}
+
+	if !assert.Equal(t, ids(expected), ids(actual), fmt.Sprintf("%s: unexpected kits", desc)) {
+		return
+	}
+	for i := range expected {
+		assertKit(t, expected[i], actual[i], desc)
+	}
+}
+
+func assertKit(t *testing.T, expected, actual mfxkit.Kit, desc string) {
+	assert.Equal(t, expected.ID, actual.ID, fmt.Sprintf("%s: expected ID %s got %s", desc, expected.ID, actual.ID))
+	assert.Equal(t, expected.Owner, actual.Owner, fmt.Sprintf("%s: expected owner %s got %s", desc, expected.Owner, actual.Owner))
+	assert.Equal(t, expected.Name, actual.Name, fmt.Sprintf("%s: expected name %s got %s", desc, expected.Name, actual.Name))
+	assert.Equal(t, expected.Metadata, actual.Metadata, fmt.Sprintf("%s: expected metadata %v got %v", desc, expected.Metadata, actual.Metadata))
+	assert.Equal(t, expected.Revision, actual.Revision, fmt.Sprintf("%s: expected revision %d got %d", desc, expected.Revision, actual.Revision))
+	assert.True(t, expected.CreatedAt.Equal(actual.CreatedAt), fmt.Sprintf("%s: expected created at %s got %s", desc, expected.CreatedAt, actual.CreatedAt))
+	assert.True(t, expected.UpdatedAt.Equal(actual.UpdatedAt), fmt.Sprintf("%s: expected updated at %s got %s", desc, expected.UpdatedAt, actual.UpdatedAt))
+}
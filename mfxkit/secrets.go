@@ -0,0 +1,79 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit
+
+import (
+	"context"
+	"time"
+)
+
+// Secret represents one of the service secrets. Multiple secrets can be
+// active at the same time, so that clients can migrate to a new secret
+// before the old one expires. Each secret is identified by its key ID.
+type Secret struct {
+	// ID identifies the secret, e.g. in logs and metrics.
+	ID string
+
+	// Hash is the hash of the secret, generated by a Hasher.
+	Hash string
+
+	// NotBefore is the time the secret becomes valid. Zero value means
+	// that it is valid since forever.
+	NotBefore time.Time
+
+	// NotAfter is the time the secret expires. Zero value means that it
+	// never expires.
+	NotAfter time.Time
+}
+
+// Valid reports whether the secret can be used at the given time.
+func (s Secret) Valid(t time.Time) bool {
+	if !s.NotBefore.IsZero() && t.Before(s.NotBefore) {
+		return false
+	}
+
+	return s.NotAfter.IsZero() || t.Before(s.NotAfter)
+}
+
+// SecretProvider provides the service secrets. Providers backed by files can
+// change the secrets while the service is running, so the service asks for
+// them on every call.
+type SecretProvider interface {
+	// Secrets returns the current service secrets.
+	Secrets() []Secret
+}
+
+// StaticSecrets is the SecretProvider of the secrets that never change.
+type StaticSecrets []Secret
+
+// Secrets returns the secrets.
+func (ss StaticSecrets) Secrets() []Secret {
+	return ss
+}
+
+type keyIDCtxKey struct{}
+
+type keyIDHolder struct {
+	id string
+}
+
+// TrackKeyID returns the context that records the ID of the secret that
+// identifies the caller, and the function that returns the recorded ID once
+// the service method has returned. The context already tracking the key ID
+// is returned unchanged, so that every decorator sees the same key ID.
+func TrackKeyID(ctx context.Context) (context.Context, func() string) {
+	h, ok := ctx.Value(keyIDCtxKey{}).(*keyIDHolder)
+	if !ok {
+		h = &keyIDHolder{}
+		ctx = context.WithValue(ctx, keyIDCtxKey{}, h)
+	}
+
+	return ctx, func() string { return h.id }
+}
+
+func recordKeyID(ctx context.Context, id string) {
+	if h, ok := ctx.Value(keyIDCtxKey{}).(*keyIDHolder); ok {
+		h.id = id
+	}
+}
@@ -0,0 +1,9 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package secrets contains the service secret providers. Secrets are read
+// from environment variables, from a file, e.g. a Docker secret, or from a
+// mounted directory, e.g. a Kubernetes secret, holding a file per secret.
+// File and directory providers can be watched, so that secrets rotated on
+// disk are swapped in without restarting the service.
+package secrets
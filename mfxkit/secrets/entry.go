@@ -0,0 +1,86 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+// DefKeyID identifies the secret given on its own, rather than in a list.
+const DefKeyID = "default"
+
+var (
+	// ErrNoSecrets indicates an empty list of secrets.
+	ErrNoSecrets = errors.New("no secrets")
+
+	// ErrMissingKeyID indicates a secret without the key ID.
+	ErrMissingKeyID = errors.New("missing key ID")
+
+	// ErrDuplicateKeyID indicates several secrets with the same key ID.
+	ErrDuplicateKeyID = errors.New("duplicate key ID")
+
+	// ErrEmptySecret indicates a secret without a value.
+	ErrEmptySecret = errors.New("empty secret")
+
+	// ErrInvalidWindow indicates a secret that expires before it becomes
+	// valid.
+	ErrInvalidWindow = errors.New("not_after must be after not_before")
+)
+
+// Entry is a secret as read from its source. The secret can be plain or
+// hashed, so entries are prepared before they are used by the service.
+type Entry struct {
+	ID        string    `json:"id"`
+	Secret    string    `json:"secret"`
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
+}
+
+// PrepareFunc turns the entries into the service secrets, e.g. by hashing
+// plain secrets and rejecting weak ones.
+type PrepareFunc func(entries []Entry) ([]mfxkit.Secret, error)
+
+// Parse parses the JSON list of secrets.
+func Parse(data []byte) ([]Entry, error) {
+	var entries []Entry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	if err := Validate(entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// Validate checks that there is at least one secret, that key IDs are
+// unique, and that validity windows are not empty.
+func Validate(entries []Entry) error {
+	if len(entries) == 0 {
+		return ErrNoSecrets
+	}
+
+	ids := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		switch {
+		case e.ID == "":
+			return ErrMissingKeyID
+		case ids[e.ID]:
+			return fmt.Errorf("%w: %s", ErrDuplicateKeyID, e.ID)
+		case e.Secret == "":
+			return fmt.Errorf("%w: %s", ErrEmptySecret, e.ID)
+		case !e.NotBefore.IsZero() && !e.NotAfter.IsZero() && !e.NotAfter.After(e.NotBefore):
+			return fmt.Errorf("%w: %s", ErrInvalidWindow, e.ID)
+		}
+		ids[e.ID] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mainflux/mainflux/logger"
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+var _ mfxkit.SecretProvider = (*Provider)(nil)
+
+// Provider provides the service secrets read from their source. The
+// secrets are swapped in place when the source changes, so the service
+// keeps using the same provider.
+type Provider struct {
+	source  string
+	load    func() ([]Entry, error)
+	prepare PrepareFunc
+	static  bool
+
+	mu      sync.RWMutex
+	secrets []mfxkit.Secret
+	sum     [sha256.Size]byte
+}
+
+// NewEnv instantiates the provider of the secrets given in environment
+// variables. The JSON list of secrets takes precedence over the single
+// secret, which gets the default key ID. These secrets never change.
+func NewEnv(secret, list string, prepare PrepareFunc) (*Provider, error) {
+	load := func() ([]Entry, error) {
+		if list != "" {
+			return Parse([]byte(list))
+		}
+
+		return single(secret)
+	}
+
+	return newProvider("environment", load, prepare, true)
+}
+
+// NewFile instantiates the provider of the secrets read from the file. The
+// file holds either the JSON list of secrets, or a single secret that gets
+// the default key ID, surrounding white space ignored.
+func NewFile(path string, list bool, prepare PrepareFunc) (*Provider, error) {
+	load := func() ([]Entry, error) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if list {
+			return Parse(data)
+		}
+
+		return single(string(data))
+	}
+
+	return newProvider(path, load, prepare, false)
+}
+
+// NewDir instantiates the provider of the secrets read from the files in
+// the directory whose names start with the prefix, one secret per file, so
+// that the other files mounted in the same directory are never read. File
+// names are used as key IDs, and hidden files are skipped, such as the ones
+// Kubernetes uses to update mounted secrets atomically.
+func NewDir(path, prefix string, prepare PrepareFunc) (*Provider, error) {
+	load := func() ([]Entry, error) {
+		files, err := ioutil.ReadDir(path)
+		if err != nil {
+			return nil, err
+		}
+
+		var entries []Entry
+		for _, f := range files {
+			if strings.HasPrefix(f.Name(), ".") || !strings.HasPrefix(f.Name(), prefix) {
+				continue
+			}
+
+			// Stat follows the symbolic links to the secret files.
+			name := filepath.Join(path, f.Name())
+			fi, err := os.Stat(name)
+			if err != nil {
+				return nil, err
+			}
+			if fi.IsDir() {
+				continue
+			}
+
+			data, err := ioutil.ReadFile(name)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, Entry{
+				ID:     f.Name(),
+				Secret: strings.TrimSpace(string(data)),
+			})
+		}
+
+		if err := Validate(entries); err != nil {
+			return nil, err
+		}
+
+		return entries, nil
+	}
+
+	return newProvider(path, load, prepare, false)
+}
+
+func newProvider(source string, load func() ([]Entry, error), prepare PrepareFunc, static bool) (*Provider, error) {
+	p := &Provider{
+		source:  source,
+		load:    load,
+		prepare: prepare,
+		static:  static,
+	}
+	if _, err := p.Reload(); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// Secrets returns the current service secrets.
+func (p *Provider) Secrets() []mfxkit.Secret {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.secrets
+}
+
+// Source returns the description of the source of the secrets, i.e. the
+// path of the file or directory.
+func (p *Provider) Source() string {
+	return p.source
+}
+
+// Reload reads the secrets from the source and, if they have changed,
+// prepares them and swaps them in. It reports whether the secrets have
+// changed. The current secrets are kept if the new ones fail to load or
+// prepare, and the ones that failed to prepare are not prepared again
+// until the source changes.
+func (p *Provider) Reload() (bool, error) {
+	entries, err := p.load()
+	if err != nil {
+		return false, err
+	}
+
+	sum, err := checksum(entries)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.Lock()
+	unchanged := sum == p.sum
+	p.sum = sum
+	p.mu.Unlock()
+	if unchanged {
+		return false, nil
+	}
+
+	secrets, err := p.prepare(entries)
+	if err != nil {
+		return false, err
+	}
+
+	p.mu.Lock()
+	p.secrets = secrets
+	p.mu.Unlock()
+
+	return true, nil
+}
+
+// Watch reloads the secrets at the given interval until the context is
+// done. Rotations and failures are logged with key IDs only, never with the
+// secrets. Watch returns immediately for the secrets that never change, or
+// if the interval is not positive.
+func (p *Provider) Watch(ctx context.Context, interval time.Duration, logger logger.Logger) {
+	if p.static || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		changed, err := p.Reload()
+		switch {
+		case err != nil:
+			logger.Error(fmt.Sprintf("Failed to reload service secrets from %s, keeping the current ones: %s", p.source, err))
+		case changed:
+			logger.Info(fmt.Sprintf("Service secrets rotated from %s, key IDs: %s", p.source, strings.Join(keyIDs(p.Secrets()), ", ")))
+		}
+	}
+}
+
+func single(secret string) ([]Entry, error) {
+	entries := []Entry{{ID: DefKeyID, Secret: strings.TrimSpace(secret)}}
+	if err := Validate(entries); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func checksum(entries []Entry) ([sha256.Size]byte, error) {
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+
+	return sha256.Sum256(data), nil
+}
+
+func keyIDs(secrets []mfxkit.Secret) []string {
+	ids := make([]string, len(secrets))
+	for i, s := range secrets {
+		ids[i] = s.ID
+	}
+
+	return ids
+}
@@ -0,0 +1,90 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package secrets_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/secrets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const prefix = "mfxkit_secret"
+
+// plain returns the entries as secrets holding the plain secret as hash.
+func plain(entries []secrets.Entry) ([]mfxkit.Secret, error) {
+	ss := make([]mfxkit.Secret, len(entries))
+	for i, e := range entries {
+		ss[i] = mfxkit.Secret{ID: e.ID, Hash: e.Secret}
+	}
+
+	return ss, nil
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, data := range files {
+		err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0600)
+		require.Nil(t, err, fmt.Sprintf("write %s: unexpected error: %s", name, err))
+	}
+}
+
+func TestNewDir(t *testing.T) {
+	cases := []struct {
+		desc    string
+		files   map[string]string
+		secrets []mfxkit.Secret
+		err     error
+	}{
+		{
+			desc: "directory with secret files only",
+			files: map[string]string{
+				"mfxkit_secret":      "first\n",
+				"mfxkit_secret_next": "second",
+			},
+			secrets: []mfxkit.Secret{
+				{ID: "mfxkit_secret", Hash: "first"},
+				{ID: "mfxkit_secret_next", Hash: "second"},
+			},
+		},
+		{
+			desc: "directory with other mounted files",
+			files: map[string]string{
+				"mfxkit_secret":  "first",
+				"db_password":    "password",
+				"tls.key":        "key",
+				".mfxkit_secret": "hidden",
+			},
+			secrets: []mfxkit.Secret{
+				{ID: "mfxkit_secret", Hash: "first"},
+			},
+		},
+		{
+			desc: "directory without secret files",
+			files: map[string]string{
+				"db_password": "password",
+			},
+			err: secrets.ErrNoSecrets,
+		},
+	}
+
+	for _, tc := range cases {
+		dir := t.TempDir()
+		writeFiles(t, dir, tc.files)
+		err := os.Mkdir(filepath.Join(dir, "mfxkit_secret_dir"), 0700)
+		require.Nil(t, err, fmt.Sprintf("%s: create directory: unexpected error: %s", tc.desc, err))
+
+		p, err := secrets.NewDir(dir, prefix, plain)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+		if err != nil {
+			continue
+		}
+		assert.Equal(t, tc.secrets, p.Secrets(), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.secrets, p.Secrets()))
+	}
+}
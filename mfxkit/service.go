@@ -4,9 +4,16 @@
 package mfxkit
 
 import (
+	"context"
 	"errors"
+	"time"
+
+	"github.com/mainflux/mainflux"
 )
 
+// defOwner identifies every caller authenticated using the service secret.
+const defOwner = "mfxkit"
+
 var (
 	// ErrMalformedEntity indicates malformed entity specification (e.g.
 	// invalid username or password).
@@ -15,31 +22,415 @@ var (
 	// ErrUnauthorizedAccess indicates missing or invalid credentials provided
 	// when accessing a protected resource.
 	ErrUnauthorizedAccess = errors.New("missing or invalid credentials provided")
+
+	// ErrForbidden indicates that the identified caller is not allowed to
+	// access the resource, e.g. a thing not connected to the channel.
+	ErrForbidden = errors.New("access to the resource is forbidden")
+
+	// ErrNotFound indicates a non-existent entity request.
+	ErrNotFound = errors.New("non-existent entity")
+
+	// ErrConflict indicates that the entity has been modified since the
+	// revision the request is based on.
+	ErrConflict = errors.New("entity revision conflict")
 )
 
 // Service specifies an API that must be fullfiled by the domain service
 // implementation, and all of its decorators (e.g. logging & metrics).
+// Methods annotated with @http are exposed by the generated HTTP transport,
+// see the httpgen package. The kit methods are not annotated, since their
+// routes need what the generator does not support: the kit revision read
+// from If-Match and returned as ETag, the JSON metadata query, and the
+// per-item statuses of the bulk responses. Their transport is hand-written.
 type Service interface {
-	// Ping compares a given string with secret
-	Ping(string) (string, error)
+	// Ping compares a given string with the service secrets and returns the
+	// ID of the matching one.
+	//
+	// @http POST /mfxkit
+	// @required secret
+	Ping(ctx context.Context, secret string) (greeting, keyID string, err error)
+
+	// CreateKits adds kits to the user identified by the provided token.
+	CreateKits(ctx context.Context, token string, kits ...Kit) ([]Kit, error)
+
+	// BulkCreateKits adds kits to the user identified by the provided token
+	// and reports the outcome of each of them. If atomic is set, either all
+	// kits are created or none is.
+	BulkCreateKits(ctx context.Context, token string, atomic bool, kits ...Kit) ([]BulkResult, error)
+
+	// ViewKit retrieves data about the kit identified with the provided
+	// ID, that belongs to the user identified by the provided token.
+	ViewKit(ctx context.Context, token, id string) (Kit, error)
+
+	// UpdateKit updates the kit identified by the provided ID, that
+	// belongs to the user identified by the provided token. If the kit
+	// revision is set, it must match the current one.
+	UpdateKit(ctx context.Context, token string, kit Kit) (Kit, error)
+
+	// ListKits retrieves data about subset of kits that belong to the user
+	// identified by the provided token.
+	ListKits(ctx context.Context, token string, pm PageMetadata) (Page, error)
+
+	// RemoveKit removes the kit identified with the provided ID, that
+	// belongs to the user identified by the provided token. If the revision
+	// is set, it must match the current one.
+	RemoveKit(ctx context.Context, token, id string, rev uint64) error
+
+	// BulkRemoveKits removes kits identified by the provided IDs, that belong
+	// to the user identified by the provided token, and reports the outcome
+	// of each of them. If a kit revision is set, it must match the current
+	// one. Kits that don't exist or belong to another user are reported
+	// with ErrNotFound. If atomic is set, either all kits are removed or
+	// none is.
+	BulkRemoveKits(ctx context.Context, token string, atomic bool, kits ...Kit) ([]BulkResult, error)
+
+	// RestoreKit restores the removed kit identified with the provided ID,
+	// that belongs to the user identified by the provided token. Kits can be
+	// restored only within the retention period.
+	RestoreKit(ctx context.Context, token, id string) (Kit, error)
+
+	// PurgeKits permanently deletes kits removed before the retention period
+	// and returns the number of deleted kits. It is restricted to the callers
+	// holding one of the service secrets, regardless of the authenticator.
+	PurgeKits(ctx context.Context, secret string) (uint64, error)
 }
 
 type mfxkitService struct {
-	secret string
+	secrets    SecretProvider
+	verifier   *verifier
+	auth       Authenticator
+	kits       KitRepository
+	idProvider mainflux.IDProvider
+	retention  time.Duration
 }
 
 var _ Service = (*mfxkitService)(nil)
 
-// New instantiates the mfxkit service implementation.
-func New(secret string) Service {
+// New instantiates the mfxkit service implementation. Callers are verified
+// against the hashes of the currently valid secrets of the given provider,
+// using the given hasher, and the verified tokens are remembered for the
+// following calls. If the authenticator is not nil, kits are managed
+// by the callers it identifies instead, while ping still uses the secrets.
+// Removed kits can be restored during the retention period, after which
+// they are purged.
+func New(secrets SecretProvider, hasher Hasher, auth Authenticator, kits KitRepository, idp mainflux.IDProvider, retention time.Duration) Service {
 	return &mfxkitService{
-		secret: secret,
+		secrets:    secrets,
+		verifier:   newVerifier(hasher),
+		auth:       auth,
+		kits:       kits,
+		idProvider: idp,
+		retention:  retention,
+	}
+}
+
+func (ks *mfxkitService) Ping(ctx context.Context, secret string) (string, string, error) {
+	keyID, err := ks.authenticate(ctx, secret)
+	if err != nil {
+		return "", "", err
+	}
+	return "Hello World :)", keyID, nil
+}
+
+func (ks *mfxkitService) CreateKits(ctx context.Context, token string, kits ...Kit) ([]Kit, error) {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return []Kit{}, err
+	}
+
+	if err := ks.prepare(owner, kits); err != nil {
+		return []Kit{}, err
+	}
+
+	return ks.kits.Save(ctx, kits...)
+}
+
+func (ks *mfxkitService) BulkCreateKits(ctx context.Context, token string, atomic bool, kits ...Kit) ([]BulkResult, error) {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return []BulkResult{}, err
+	}
+
+	if err := ks.prepare(owner, kits); err != nil {
+		return []BulkResult{}, err
+	}
+
+	if atomic {
+		saved, err := ks.kits.Save(ctx, kits...)
+		if err != nil {
+			return AbortedResults(kits, err), nil
+		}
+
+		res := make([]BulkResult, len(saved))
+		for i, kit := range saved {
+			res[i] = BulkResult{Kit: kit}
+		}
+		return res, nil
+	}
+
+	res := make([]BulkResult, len(kits))
+	for i, kit := range kits {
+		res[i] = BulkResult{Kit: kit}
+		saved, err := ks.kits.Save(ctx, kit)
+		if err != nil {
+			res[i].Err = err
+			continue
+		}
+		res[i].Kit = saved[0]
+	}
+
+	return res, nil
+}
+
+func (ks *mfxkitService) ViewKit(ctx context.Context, token, id string) (Kit, error) {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return Kit{}, err
+	}
+
+	kit, err := ks.kits.RetrieveByID(ctx, owner, id)
+	if err != nil {
+		return Kit{}, err
+	}
+
+	return kit, checkOwner(ctx, kit)
+}
+
+func (ks *mfxkitService) UpdateKit(ctx context.Context, token string, kit Kit) (Kit, error) {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return Kit{}, err
+	}
+
+	kit.Owner = owner
+	kit.UpdatedAt = time.Now().UTC()
+
+	kit, err = ks.kits.Update(ctx, kit)
+	if err != nil {
+		return Kit{}, err
 	}
+
+	return kit, checkOwner(ctx, kit)
 }
 
-func (ks *mfxkitService) Ping(secret string) (string, error) {
-	if ks.secret != secret {
-		return "", ErrUnauthorizedAccess
+func (ks *mfxkitService) ListKits(ctx context.Context, token string, pm PageMetadata) (Page, error) {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return Page{}, err
+	}
+
+	if err := pm.Validate(); err != nil {
+		return Page{}, err
 	}
-	return "Hello World :)", nil
+
+	page, err := ks.kits.RetrieveAll(ctx, owner, pm.Normalize())
+	if err != nil {
+		return Page{}, err
+	}
+
+	return ownedPage(ctx, page), nil
+}
+
+func (ks *mfxkitService) RemoveKit(ctx context.Context, token, id string, rev uint64) error {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if err := ks.owned(ctx, owner, id); err != nil {
+		return err
+	}
+
+	return ks.kits.Remove(ctx, owner, id, rev, time.Now().UTC())
+}
+
+func (ks *mfxkitService) BulkRemoveKits(ctx context.Context, token string, atomic bool, kits ...Kit) ([]BulkResult, error) {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return []BulkResult{}, err
+	}
+
+	now := time.Now().UTC()
+	if atomic {
+		for i, kit := range kits {
+			if err := ks.owned(ctx, owner, kit.ID); err != nil {
+				return AbortedResults(kits, &ItemError{Index: i, Err: err}), nil
+			}
+		}
+		if err := ks.kits.BulkRemove(ctx, owner, now, kits...); err != nil {
+			return AbortedResults(kits, err), nil
+		}
+
+		res := make([]BulkResult, len(kits))
+		for i, kit := range kits {
+			res[i] = BulkResult{Kit: kit}
+		}
+		return res, nil
+	}
+
+	res := make([]BulkResult, len(kits))
+	for i, kit := range kits {
+		res[i] = BulkResult{Kit: kit}
+		if err := ks.owned(ctx, owner, kit.ID); err != nil {
+			res[i].Err = err
+			continue
+		}
+		res[i].Err = ks.kits.Remove(ctx, owner, kit.ID, kit.Revision, now)
+	}
+
+	return res, nil
+}
+
+// owned returns ErrNotFound unless the kit having the provided identifier
+// exists and is owned by the caller.
+func (ks *mfxkitService) owned(ctx context.Context, owner, id string) error {
+	kit, err := ks.kits.RetrieveByID(ctx, owner, id)
+	if err != nil {
+		return err
+	}
+
+	return checkOwner(ctx, kit)
+}
+
+func (ks *mfxkitService) RestoreKit(ctx context.Context, token, id string) (Kit, error) {
+	ctx, owner, err := ks.identify(ctx, token)
+	if err != nil {
+		return Kit{}, err
+	}
+
+	kit, err := ks.kits.Restore(ctx, owner, id, time.Now().UTC().Add(-ks.retention))
+	if err != nil {
+		return Kit{}, err
+	}
+
+	return kit, checkOwner(ctx, kit)
+}
+
+func (ks *mfxkitService) PurgeKits(ctx context.Context, secret string) (uint64, error) {
+	if _, err := ks.authenticate(ctx, secret); err != nil {
+		return 0, err
+	}
+
+	return ks.kits.Purge(ctx, time.Now().UTC().Add(-ks.retention))
+}
+
+// prepare assigns identifiers, ownership and timestamps to the new kits.
+func (ks *mfxkitService) prepare(owner string, kits []Kit) error {
+	now := time.Now().UTC()
+	for i := range kits {
+		id, err := ks.idProvider.ID()
+		if err != nil {
+			return err
+		}
+
+		kits[i].ID = id
+		kits[i].Owner = owner
+		kits[i].Revision = 1
+		kits[i].CreatedAt = now
+		kits[i].UpdatedAt = now
+	}
+
+	return nil
+}
+
+// identify returns the owner of the caller kits, and the context carrying
+// the caller identity for ownership checks. Callers are identified by the
+// authenticator, if any, or by the service secrets.
+func (ks *mfxkitService) identify(ctx context.Context, token string) (context.Context, string, error) {
+	id := Identity{ID: defOwner}
+	if ks.auth != nil {
+		var err error
+		if id, err = ks.auth.Identify(ctx, token); err != nil {
+			return ctx, "", err
+		}
+	} else if _, err := ks.authenticate(ctx, token); err != nil {
+		return ctx, "", err
+	}
+
+	ctx = WithIdentity(ctx, id)
+	if err := ks.authorize(ctx); err != nil {
+		return ctx, "", err
+	}
+
+	return ctx, id.ID, nil
+}
+
+// authorize checks that the thing identified by the context can access the
+// channel carried by the context, if the authenticator authorizes things.
+// Things the authenticator already authorized for the channel while
+// identifying them are not authorized again.
+func (ks *mfxkitService) authorize(ctx context.Context) error {
+	authz, ok := ks.auth.(Authorizer)
+	if !ok {
+		return nil
+	}
+
+	id, ok := IdentityFromContext(ctx)
+	if !ok || id.ThingID == "" {
+		return nil
+	}
+
+	chanID, ok := ChannelIDFromContext(ctx)
+	if !ok {
+		return ErrForbidden
+	}
+	if id.ChannelID == chanID {
+		return nil
+	}
+
+	return authz.Authorize(ctx, id.ThingID, chanID)
+}
+
+// checkOwner reports the kit that does not belong to the caller identified
+// by the context as non-existent, so that kits never leak to other callers
+// even if the repository fails to scope them by their owner.
+func checkOwner(ctx context.Context, kit Kit) error {
+	id, ok := IdentityFromContext(ctx)
+	if !ok || kit.Owner != id.ID {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ownedPage drops the kits of the page that do not belong to the caller
+// identified by the context.
+func ownedPage(ctx context.Context, page Page) Page {
+	owned := make([]Kit, 0, len(page.Kits))
+	for _, kit := range page.Kits {
+		if checkOwner(ctx, kit) == nil {
+			owned = append(owned, kit)
+		}
+	}
+
+	if dropped := uint64(len(page.Kits) - len(owned)); dropped > 0 {
+		page.Total -= dropped
+	}
+	page.Kits = owned
+
+	return page
+}
+
+// authenticate returns the ID of the currently valid secret matching the
+// token and records it in the context, if the context tracks it.
+func (ks *mfxkitService) authenticate(ctx context.Context, token string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	now := time.Now()
+	var valid []Secret
+	for _, s := range ks.secrets.Secrets() {
+		if s.Valid(now) {
+			valid = append(valid, s)
+		}
+	}
+
+	s, err := ks.verifier.verify(ctx, token, valid)
+	if err != nil {
+		return "", err
+	}
+	recordKeyID(ctx, s.ID)
+
+	return s.ID, nil
 }
@@ -0,0 +1,306 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mainflux/mainflux/pkg/uuid"
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/hasher"
+	"github.com/mainflux/mfxkit/mfxkit/memory"
+	"github.com/mainflux/mfxkit/mfxkit/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	secret     = "0123456789abcdef"
+	retention  = time.Hour
+	token      = "token"
+	otherToken = "other-token"
+)
+
+var (
+	user      = mfxkit.Identity{ID: "user", Email: "user@example.com"}
+	otherUser = mfxkit.Identity{ID: "other", Email: "other@example.com"}
+)
+
+// fakeAuth identifies the owners of the known tokens.
+type fakeAuth map[string]mfxkit.Identity
+
+func (fa fakeAuth) Identify(_ context.Context, token string) (mfxkit.Identity, error) {
+	id, ok := fa[token]
+	if !ok {
+		return mfxkit.Identity{}, mfxkit.ErrUnauthorizedAccess
+	}
+
+	return id, nil
+}
+
+// countingHasher counts the comparisons of the wrapped hasher.
+type countingHasher struct {
+	mfxkit.Hasher
+	mu    sync.Mutex
+	count int
+}
+
+func (ch *countingHasher) Compare(secret, hash string) error {
+	ch.mu.Lock()
+	ch.count++
+	ch.mu.Unlock()
+
+	return ch.Hasher.Compare(secret, hash)
+}
+
+func (ch *countingHasher) compared() int {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	return ch.count
+}
+
+// rotatingSecrets is a SecretProvider whose secrets can be replaced.
+type rotatingSecrets struct {
+	mu      sync.Mutex
+	secrets []mfxkit.Secret
+}
+
+func (rs *rotatingSecrets) Secrets() []mfxkit.Secret {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	return rs.secrets
+}
+
+func (rs *rotatingSecrets) set(secrets ...mfxkit.Secret) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.secrets = secrets
+}
+
+func newSecret(t *testing.T, h mfxkit.Hasher, id, secret string) mfxkit.Secret {
+	hash, err := h.Hash(secret)
+	require.Nil(t, err, fmt.Sprintf("hash secret: unexpected error: %s", err))
+
+	return mfxkit.Secret{ID: id, Hash: hash}
+}
+
+func TestPingRemembersVerifiedSecrets(t *testing.T) {
+	h := &countingHasher{Hasher: hasher.NewBcrypt(4)}
+	provider := &rotatingSecrets{}
+	provider.set(newSecret(t, h, "old", "fedcba9876543210"), newSecret(t, h, "new", secret))
+	svc := mfxkit.New(provider, h, nil, memory.NewKitRepository(), uuid.NewMock(), retention)
+
+	cases := []struct {
+		desc     string
+		secret   string
+		keyID    string
+		err      error
+		compared int
+	}{
+		{"ping with a secret", secret, "new", nil, 2},
+		{"ping with the remembered secret", secret, "new", nil, 0},
+		{"ping with an invalid secret", "invalid", "", mfxkit.ErrUnauthorizedAccess, 2},
+		{"ping with the invalid secret again", "invalid", "", mfxkit.ErrUnauthorizedAccess, 2},
+	}
+
+	for _, tc := range cases {
+		before := h.compared()
+		_, keyID, err := svc.Ping(context.Background(), tc.secret)
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+		assert.Equal(t, tc.keyID, keyID, fmt.Sprintf("%s: expected key ID %s got %s", tc.desc, tc.keyID, keyID))
+		compared := h.compared() - before
+		assert.Equal(t, tc.compared, compared, fmt.Sprintf("%s: expected %d comparisons got %d", tc.desc, tc.compared, compared))
+	}
+
+	provider.set(newSecret(t, h, "rotated", "rotated-0123456789"))
+	_, _, err := svc.Ping(context.Background(), secret)
+	assert.Equal(t, mfxkit.ErrUnauthorizedAccess, err, fmt.Sprintf("ping with the rotated secret: expected %s got %v", mfxkit.ErrUnauthorizedAccess, err))
+}
+
+func newOwnedService(repo mfxkit.KitRepository) mfxkit.Service {
+	auth := fakeAuth{token: user, otherToken: otherUser}
+	return mfxkit.New(mfxkit.StaticSecrets{}, hasher.NewArgon2id(), auth, repo, uuid.NewMock(), retention)
+}
+
+func TestKitsOfOtherOwners(t *testing.T) {
+	svc := newOwnedService(memory.NewKitRepository())
+	saved, err := svc.CreateKits(context.Background(), token, mfxkit.Kit{Name: "kit"})
+	require.Nil(t, err, fmt.Sprintf("create kit: unexpected error: %s", err))
+	kit := saved[0]
+
+	cases := []struct {
+		desc  string
+		call  func(token string) error
+		owner error
+		other error
+	}{
+		{
+			desc: "view kit",
+			call: func(token string) error {
+				_, err := svc.ViewKit(context.Background(), token, kit.ID)
+				return err
+			},
+			other: mfxkit.ErrNotFound,
+		},
+		{
+			desc: "update kit",
+			call: func(token string) error {
+				_, err := svc.UpdateKit(context.Background(), token, mfxkit.Kit{ID: kit.ID, Name: "renamed"})
+				return err
+			},
+			other: mfxkit.ErrNotFound,
+		},
+		{
+			desc: "remove kit",
+			call: func(token string) error {
+				return svc.RemoveKit(context.Background(), token, kit.ID, 0)
+			},
+			other: mfxkit.ErrNotFound,
+		},
+		{
+			desc: "restore kit",
+			call: func(token string) error {
+				_, err := svc.RestoreKit(context.Background(), token, kit.ID)
+				return err
+			},
+			other: mfxkit.ErrNotFound,
+		},
+	}
+
+	for _, tc := range cases {
+		err := tc.call(otherToken)
+		assert.Equal(t, tc.other, err, fmt.Sprintf("%s by other owner: expected %v got %v", tc.desc, tc.other, err))
+		err = tc.call(token)
+		assert.Equal(t, tc.owner, err, fmt.Sprintf("%s by owner: expected %v got %v", tc.desc, tc.owner, err))
+	}
+
+	page, err := svc.ListKits(context.Background(), otherToken, mfxkit.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("list kits: unexpected error: %s", err))
+	assert.Empty(t, page.Kits, "list kits by other owner: expected no kits")
+}
+
+func TestKitsLeakedByRepository(t *testing.T) {
+	leaked := mfxkit.Kit{ID: "leaked", Owner: otherUser.ID, Name: "leaked", Revision: 1}
+	owned := mfxkit.Kit{ID: "owned", Owner: user.ID, Name: "owned", Revision: 1}
+
+	repo := mocks.NewKitRepository()
+	for _, method := range []string{"RetrieveByID", "Update", "Restore"} {
+		repo.Script(method, mocks.Behaviour{Result: leaked})
+	}
+	repo.Script("RetrieveAll", mocks.Behaviour{Result: mfxkit.Page{
+		PageMetadata: mfxkit.PageMetadata{Total: 2, Limit: 10},
+		Kits:         []mfxkit.Kit{leaked, owned},
+	}})
+	svc := newOwnedService(repo)
+
+	_, err := svc.ViewKit(context.Background(), token, leaked.ID)
+	assert.Equal(t, mfxkit.ErrNotFound, err, fmt.Sprintf("view leaked kit: expected %s got %v", mfxkit.ErrNotFound, err))
+
+	_, err = svc.UpdateKit(context.Background(), token, mfxkit.Kit{ID: leaked.ID})
+	assert.Equal(t, mfxkit.ErrNotFound, err, fmt.Sprintf("update leaked kit: expected %s got %v", mfxkit.ErrNotFound, err))
+
+	err = svc.RemoveKit(context.Background(), token, leaked.ID, 0)
+	assert.Equal(t, mfxkit.ErrNotFound, err, fmt.Sprintf("remove leaked kit: expected %s got %v", mfxkit.ErrNotFound, err))
+	assert.Empty(t, repo.CallsTo("Remove"), "remove leaked kit: expected the kit not to be removed")
+
+	_, err = svc.RestoreKit(context.Background(), token, leaked.ID)
+	assert.Equal(t, mfxkit.ErrNotFound, err, fmt.Sprintf("restore leaked kit: expected %s got %v", mfxkit.ErrNotFound, err))
+
+	page, err := svc.ListKits(context.Background(), token, mfxkit.PageMetadata{Limit: 10})
+	require.Nil(t, err, fmt.Sprintf("list kits: unexpected error: %s", err))
+	assert.Equal(t, []mfxkit.Kit{owned}, page.Kits, "list kits: expected only the owned kit")
+	assert.Equal(t, uint64(1), page.Total, fmt.Sprintf("list kits: expected total 1 got %d", page.Total))
+}
+
+// fakeThingsAuth identifies the things by their keys and authorizes them to
+// access the channels they are connected to.
+type fakeThingsAuth struct {
+	fakeAuth
+	channels map[string]string
+}
+
+func (fta fakeThingsAuth) Authorize(_ context.Context, thingID, chanID string) error {
+	if fta.channels[thingID] != chanID {
+		return mfxkit.ErrForbidden
+	}
+
+	return nil
+}
+
+func TestAuthorizeThings(t *testing.T) {
+	thing := mfxkit.Identity{ID: "thing", ThingID: "thing"}
+	authorized := mfxkit.Identity{ID: "authorized", ThingID: "authorized", ChannelID: "channel"}
+	authn := fakeThingsAuth{
+		fakeAuth: fakeAuth{token: user, "thing-key": thing, "authorized-key": authorized},
+		channels: map[string]string{thing.ThingID: "channel"},
+	}
+	svc := mfxkit.New(mfxkit.StaticSecrets{}, hasher.NewArgon2id(), authn, memory.NewKitRepository(), uuid.NewMock(), retention)
+
+	cases := []struct {
+		desc  string
+		ctx   context.Context
+		token string
+		err   error
+	}{
+		{"list kits by thing connected to channel", mfxkit.WithChannelID(context.Background(), "channel"), "thing-key", nil},
+		{"list kits by thing not connected to channel", mfxkit.WithChannelID(context.Background(), "other"), "thing-key", mfxkit.ErrForbidden},
+		{"list kits by thing without channel", context.Background(), "thing-key", mfxkit.ErrForbidden},
+		{"list kits by user with channel", mfxkit.WithChannelID(context.Background(), "other"), token, nil},
+		{"list kits by thing authorized for channel", mfxkit.WithChannelID(context.Background(), "channel"), "authorized-key", nil},
+		{"list kits by thing authorized for other channel", mfxkit.WithChannelID(context.Background(), "other"), "authorized-key", mfxkit.ErrForbidden},
+	}
+
+	for _, tc := range cases {
+		_, err := svc.ListKits(tc.ctx, tc.token, mfxkit.PageMetadata{Limit: 10})
+		assert.Equal(t, tc.err, err, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+	}
+}
+
+func TestBulkRemoveKits(t *testing.T) {
+	cases := []struct {
+		desc   string
+		atomic bool
+		errs   []error
+		kept   bool
+	}{
+		{
+			desc:   "remove owned, other owner's and missing kits",
+			atomic: false,
+			errs:   []error{nil, mfxkit.ErrNotFound, mfxkit.ErrNotFound},
+			kept:   false,
+		},
+		{
+			desc:   "remove owned, other owner's and missing kits atomically",
+			atomic: true,
+			errs:   []error{mfxkit.ErrBulkAborted, mfxkit.ErrNotFound, mfxkit.ErrBulkAborted},
+			kept:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		svc := newOwnedService(memory.NewKitRepository())
+		owned, err := svc.CreateKits(context.Background(), token, mfxkit.Kit{Name: "owned"})
+		require.Nil(t, err, fmt.Sprintf("%s: create kit: unexpected error: %s", tc.desc, err))
+		other, err := svc.CreateKits(context.Background(), otherToken, mfxkit.Kit{Name: "other"})
+		require.Nil(t, err, fmt.Sprintf("%s: create kit: unexpected error: %s", tc.desc, err))
+
+		res, err := svc.BulkRemoveKits(context.Background(), token, tc.atomic, owned[0], other[0], mfxkit.Kit{ID: "missing"})
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		for i, r := range res {
+			assert.Equal(t, tc.errs[i], r.Err, fmt.Sprintf("%s: item %d: expected %v got %v", tc.desc, i, tc.errs[i], r.Err))
+		}
+
+		_, err = svc.ViewKit(context.Background(), token, owned[0].ID)
+		assert.Equal(t, tc.kept, err == nil, fmt.Sprintf("%s: expected owned kit kept %t got error %v", tc.desc, tc.kept, err))
+		_, err = svc.ViewKit(context.Background(), otherToken, other[0].ID)
+		assert.Nil(t, err, fmt.Sprintf("%s: expected other owner's kit kept got error %v", tc.desc, err))
+	}
+}
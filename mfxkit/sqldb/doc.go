@@ -0,0 +1,6 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sqldb contains repository implementations using database/sql.
+// The same schema and queries are used with PostgreSQL and SQLite.
+package sqldb
@@ -0,0 +1,217 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sqldb
+
+import (
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+)
+
+const (
+	// Postgres is the database type of PostgreSQL server.
+	Postgres = "postgres"
+
+	// SQLite is the database type of SQLite file. Database name is used as
+	// the file path.
+	SQLite = "sqlite"
+)
+
+// ErrUnsupportedType indicates unknown database type.
+var ErrUnsupportedType = errors.New("unsupported database type")
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// Config defines the options that are used when connecting to the database.
+type Config struct {
+	Type        string
+	Host        string
+	Port        string
+	User        string
+	Pass        string
+	Name        string
+	SSLMode     string
+	SSLCert     string
+	SSLKey      string
+	SSLRootCert string
+}
+
+// Connect creates a connection to the database specified by the config.
+func Connect(cfg Config) (*sql.DB, error) {
+	var db *sql.DB
+	var err error
+
+	switch cfg.Type {
+	case Postgres:
+		// Timestamps are stored without time zone, so the session time zone
+		// is fixed to UTC the same way the service stores them.
+		url := fmt.Sprintf("host=%s port=%s user=%s dbname=%s password=%s sslmode=%s sslcert=%s sslkey=%s sslrootcert=%s timezone=UTC", cfg.Host, cfg.Port, cfg.User, cfg.Name, cfg.Pass, cfg.SSLMode, cfg.SSLCert, cfg.SSLKey, cfg.SSLRootCert)
+		db, err = sql.Open("postgres", url)
+	case SQLite:
+		db, err = sql.Open("sqlite3", cfg.Name)
+		// SQLite does not handle concurrent writers, and in-memory database
+		// exists only as long as its connection.
+		if db != nil {
+			db.SetMaxOpenConns(1)
+		}
+	default:
+		return nil, ErrUnsupportedType
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// dialect returns the database type of the connection, or an empty string
+// if the database is not supported.
+func dialect(db *sql.DB) string {
+	switch db.Driver().(type) {
+	case *pq.Driver:
+		return Postgres
+	case *sqlite3.SQLiteDriver:
+		return SQLite
+	default:
+		return ""
+	}
+}
+
+type migration struct {
+	version uint64
+	name    string
+	query   string
+}
+
+// Migrate applies all embedded migrations that have not been applied yet in
+// the order of their versions, and returns the number of applied migrations.
+func Migrate(db *sql.DB) (int, error) {
+	q := `CREATE TABLE IF NOT EXISTS schema_migrations (
+		version    BIGINT PRIMARY KEY,
+		applied_at TIMESTAMP NOT NULL
+	)`
+	if _, err := db.Exec(q); err != nil {
+		return 0, err
+	}
+
+	migs, err := loadMigrations(dialect(db))
+	if err != nil {
+		return 0, err
+	}
+
+	applied, err := appliedVersions(db)
+	if err != nil {
+		return 0, err
+	}
+
+	n := 0
+	for _, m := range migs {
+		if applied[m.version] {
+			continue
+		}
+
+		if err := apply(db, m); err != nil {
+			return n, fmt.Errorf("migration %s: %w", m.name, err)
+		}
+		n++
+	}
+
+	return n, nil
+}
+
+func appliedVersions(db *sql.DB) (map[uint64]bool, error) {
+	rows, err := db.Query(`SELECT version FROM schema_migrations`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	applied := map[uint64]bool{}
+	for rows.Next() {
+		var v uint64
+		if err := rows.Scan(&v); err != nil {
+			return nil, err
+		}
+		applied[v] = true
+	}
+
+	return applied, rows.Err()
+}
+
+func apply(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec(m.query); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	q := `INSERT INTO schema_migrations (version, applied_at) VALUES ($1, $2)`
+	if _, err := tx.Exec(q, m.version, time.Now().UTC()); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// loadMigrations reads embedded migrations of the given database type. Each
+// migration file name starts with its version followed by an underscore,
+// e.g. 0001_create_kits.sql. Migrations meant for a single database type
+// name it before the extension, e.g. 0002_kits_metadata_jsonb.postgres.sql.
+func loadMigrations(dbType string) ([]migration, error) {
+	entries, err := migrations.ReadDir("migrations")
+	if err != nil {
+		return nil, err
+	}
+
+	migs := []migration{}
+	for _, e := range entries {
+		name := e.Name()
+		if t := path.Ext(strings.TrimSuffix(name, ".sql")); t != "" && t[1:] != dbType {
+			continue
+		}
+
+		i := strings.Index(name, "_")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid migration name %s", name)
+		}
+
+		v, err := strconv.ParseUint(name[:i], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration name %s", name)
+		}
+
+		query, err := migrations.ReadFile(path.Join("migrations", name))
+		if err != nil {
+			return nil, err
+		}
+
+		migs = append(migs, migration{version: v, name: name, query: string(query)})
+	}
+
+	sort.Slice(migs, func(i, j int) bool {
+		return migs[i].version < migs[j].version
+	})
+
+	return migs, nil
+}
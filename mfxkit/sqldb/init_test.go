@@ -0,0 +1,34 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sqldb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	cases := []struct {
+		desc     string
+		dbType   string
+		versions []uint64
+	}{
+		{"load PostgreSQL migrations", Postgres, []uint64{1, 2}},
+		{"load SQLite migrations", SQLite, []uint64{1}},
+	}
+
+	for _, tc := range cases {
+		migs, err := loadMigrations(tc.dbType)
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+
+		versions := []uint64{}
+		for _, m := range migs {
+			versions = append(versions, m.version)
+		}
+		assert.Equal(t, tc.versions, versions, fmt.Sprintf("%s: expected versions %v got %v", tc.desc, tc.versions, versions))
+	}
+}
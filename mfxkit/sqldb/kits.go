@@ -0,0 +1,367 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+)
+
+const kitColumns = `id, owner, name, metadata, revision, created_at, updated_at`
+
+var _ mfxkit.KitRepository = (*kitRepository)(nil)
+
+type kitRepository struct {
+	db      *sql.DB
+	dialect string
+}
+
+// NewKitRepository instantiates a SQL implementation of kit repository.
+func NewKitRepository(db *sql.DB) mfxkit.KitRepository {
+	return &kitRepository{
+		db:      db,
+		dialect: dialect(db),
+	}
+}
+
+func (kr kitRepository) Save(ctx context.Context, kits ...mfxkit.Kit) ([]mfxkit.Kit, error) {
+	tx, err := kr.db.BeginTx(ctx, nil)
+	if err != nil {
+		return []mfxkit.Kit{}, err
+	}
+
+	q := `INSERT INTO kits (` + kitColumns + `) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	for i, kit := range kits {
+		md, err := json.Marshal(kit.Metadata)
+		if err != nil {
+			tx.Rollback()
+			return []mfxkit.Kit{}, &mfxkit.ItemError{Index: i, Err: mfxkit.ErrMalformedEntity}
+		}
+
+		if _, err := tx.ExecContext(ctx, q, kit.ID, kit.Owner, kit.Name, string(md), kit.Revision, kit.CreatedAt, kit.UpdatedAt); err != nil {
+			tx.Rollback()
+			return []mfxkit.Kit{}, &mfxkit.ItemError{Index: i, Err: err}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return []mfxkit.Kit{}, err
+	}
+
+	return kits, nil
+}
+
+func (kr kitRepository) Update(ctx context.Context, kit mfxkit.Kit) (mfxkit.Kit, error) {
+	md, err := json.Marshal(kit.Metadata)
+	if err != nil {
+		return mfxkit.Kit{}, mfxkit.ErrMalformedEntity
+	}
+
+	tx, err := kr.db.BeginTx(ctx, nil)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	defer tx.Rollback()
+
+	q := `UPDATE kits SET name = $1, metadata = $2, updated_at = $3, revision = revision + 1
+		WHERE id = $4 AND owner = $5 AND deleted_at IS NULL`
+	args := []interface{}{kit.Name, string(md), kit.UpdatedAt, kit.ID, kit.Owner}
+	if kit.Revision != 0 {
+		q = fmt.Sprintf("%s AND revision = $6", q)
+		args = append(args, kit.Revision)
+	}
+
+	if err := execOne(ctx, tx, kit.Owner, kit.ID, q, args...); err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	updated, err := retrieve(ctx, tx, kit.Owner, kit.ID)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return updated, tx.Commit()
+}
+
+func (kr kitRepository) RetrieveByID(ctx context.Context, owner, id string) (mfxkit.Kit, error) {
+	return retrieve(ctx, kr.db, owner, id)
+}
+
+func (kr kitRepository) RetrieveAll(ctx context.Context, owner string, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	where := `owner = $1 AND deleted_at IS NULL`
+	args := []interface{}{owner}
+	if pm.Name != "" {
+		where = fmt.Sprintf(`%s AND LOWER(name) LIKE $2 ESCAPE '\'`, where)
+		args = append(args, "%"+escapeLike(strings.ToLower(pm.Name))+"%")
+	}
+
+	if len(pm.Metadata) != 0 && kr.dialect == Postgres {
+		md, err := json.Marshal(pm.Metadata)
+		if err != nil {
+			return mfxkit.Page{}, mfxkit.ErrMalformedEntity
+		}
+		args = append(args, string(md))
+		where = fmt.Sprintf(`%s AND metadata @> $%d`, where, len(args))
+	}
+
+	q := fmt.Sprintf(`SELECT %s FROM kits WHERE %s ORDER BY %s`, kitColumns, where, orderBy(pm))
+	if len(pm.Metadata) != 0 && kr.dialect != Postgres {
+		return kr.retrieveByMetadata(ctx, q, args, pm)
+	}
+	q = fmt.Sprintf(`%s LIMIT %d OFFSET %d`, q, pm.Limit, pm.Offset)
+
+	rows, err := kr.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return mfxkit.Page{}, err
+	}
+	defer rows.Close()
+
+	kits := []mfxkit.Kit{}
+	for rows.Next() {
+		kit, err := scanKit(rows)
+		if err != nil {
+			return mfxkit.Page{}, err
+		}
+
+		kits = append(kits, kit)
+	}
+	if err := rows.Err(); err != nil {
+		return mfxkit.Page{}, err
+	}
+	rows.Close()
+
+	page := mfxkit.Page{
+		PageMetadata: pm,
+		Kits:         kits,
+	}
+
+	cq := fmt.Sprintf(`SELECT COUNT(*) FROM kits WHERE %s`, where)
+	if err := kr.db.QueryRowContext(ctx, cq, args...).Scan(&page.Total); err != nil {
+		return mfxkit.Page{}, err
+	}
+
+	return page, nil
+}
+
+// retrieveByMetadata pages the kits selected by the query that also match
+// the metadata filter, for SQLite, which stores metadata as text. Postgres
+// stores it as JSONB and filters it in the query instead. The owner kits are
+// streamed in the order of the query, which keeps the order the same as
+// without the filter, and only the kits of the requested page are kept, so
+// memory use is bounded by the page size. Every live kit of the owner
+// matching the name filter is still read to count the total.
+func (kr kitRepository) retrieveByMetadata(ctx context.Context, q string, args []interface{}, pm mfxkit.PageMetadata) (mfxkit.Page, error) {
+	rows, err := kr.db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return mfxkit.Page{}, err
+	}
+	defer rows.Close()
+
+	// The name is already filtered by the query, the same way as without
+	// the metadata filter.
+	filter := mfxkit.PageMetadata{Metadata: pm.Metadata}
+	page := mfxkit.Page{
+		PageMetadata: pm,
+		Kits:         []mfxkit.Kit{},
+	}
+	for rows.Next() {
+		kit, err := scanKit(rows)
+		if err != nil {
+			return mfxkit.Page{}, err
+		}
+
+		if !filter.Matches(kit) {
+			continue
+		}
+		if page.Total >= pm.Offset && uint64(len(page.Kits)) < pm.Limit {
+			page.Kits = append(page.Kits, kit)
+		}
+		page.Total++
+	}
+	if err := rows.Err(); err != nil {
+		return mfxkit.Page{}, err
+	}
+
+	return page, nil
+}
+
+func (kr kitRepository) Remove(ctx context.Context, owner, id string, rev uint64, at time.Time) error {
+	tx, err := kr.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := remove(ctx, tx, owner, id, rev, at); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (kr kitRepository) BulkRemove(ctx context.Context, owner string, at time.Time, kits ...mfxkit.Kit) error {
+	tx, err := kr.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for i, kit := range kits {
+		if err := remove(ctx, tx, owner, kit.ID, kit.Revision, at); err != nil {
+			return &mfxkit.ItemError{Index: i, Err: err}
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (kr kitRepository) Restore(ctx context.Context, owner, id string, since time.Time) (mfxkit.Kit, error) {
+	tx, err := kr.db.BeginTx(ctx, nil)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	defer tx.Rollback()
+
+	q := `UPDATE kits SET deleted_at = NULL, revision = revision + 1
+		WHERE id = $1 AND owner = $2 AND deleted_at IS NOT NULL AND deleted_at >= $3`
+	res, err := tx.ExecContext(ctx, q, id, owner, since)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+	if cnt != 1 {
+		return mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	kit, err := retrieve(ctx, tx, owner, id)
+	if err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	return kit, tx.Commit()
+}
+
+func (kr kitRepository) Purge(ctx context.Context, before time.Time) (uint64, error) {
+	q := `DELETE FROM kits WHERE deleted_at IS NOT NULL AND deleted_at < $1`
+	res, err := kr.db.ExecContext(ctx, q, before)
+	if err != nil {
+		return 0, err
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(cnt), nil
+}
+
+// remove marks the kit as deleted. Removing a missing kit is not an error,
+// while removing a kit with a different revision is.
+func remove(ctx context.Context, tx *sql.Tx, owner, id string, rev uint64, at time.Time) error {
+	q := `UPDATE kits SET deleted_at = $1, revision = revision + 1
+		WHERE id = $2 AND owner = $3 AND deleted_at IS NULL`
+	args := []interface{}{at, id, owner}
+	if rev != 0 {
+		q = fmt.Sprintf("%s AND revision = $4", q)
+		args = append(args, rev)
+	}
+
+	err := execOne(ctx, tx, owner, id, q, args...)
+	if err == mfxkit.ErrNotFound {
+		return nil
+	}
+
+	return err
+}
+
+// execOne executes the statement that is expected to modify exactly one
+// live kit. If no kit is modified, it tells apart a missing kit from the one
+// whose revision does not match.
+func execOne(ctx context.Context, tx *sql.Tx, owner, id, query string, args ...interface{}) error {
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	cnt, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if cnt == 1 {
+		return nil
+	}
+
+	if _, err := retrieve(ctx, tx, owner, id); err != nil {
+		return err
+	}
+
+	return mfxkit.ErrConflict
+}
+
+// queryer is implemented by both database and transaction.
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+func retrieve(ctx context.Context, q queryer, owner, id string) (mfxkit.Kit, error) {
+	query := `SELECT ` + kitColumns + ` FROM kits WHERE id = $1 AND owner = $2 AND deleted_at IS NULL`
+
+	kit, err := scanKit(q.QueryRowContext(ctx, query, id, owner))
+	if err == sql.ErrNoRows {
+		return mfxkit.Kit{}, mfxkit.ErrNotFound
+	}
+
+	return kit, err
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanKit(s scanner) (mfxkit.Kit, error) {
+	var kit mfxkit.Kit
+	var md sql.NullString
+
+	if err := s.Scan(&kit.ID, &kit.Owner, &kit.Name, &md, &kit.Revision, &kit.CreatedAt, &kit.UpdatedAt); err != nil {
+		return mfxkit.Kit{}, err
+	}
+
+	if md.Valid {
+		if err := json.Unmarshal([]byte(md.String), &kit.Metadata); err != nil {
+			return mfxkit.Kit{}, err
+		}
+	}
+	kit.CreatedAt = kit.CreatedAt.UTC()
+	kit.UpdatedAt = kit.UpdatedAt.UTC()
+
+	return kit, nil
+}
+
+func orderBy(pm mfxkit.PageMetadata) string {
+	dir := "ASC"
+	if pm.Dir == mfxkit.DescDir {
+		dir = "DESC"
+	}
+
+	if pm.Order == mfxkit.OrderByName {
+		return fmt.Sprintf("name %s, id %s", dir, dir)
+	}
+
+	return fmt.Sprintf("id %s", dir)
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sqldb_test
+
+import (
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mainflux/mfxkit/mfxkit"
+	"github.com/mainflux/mfxkit/mfxkit/repotest"
+	"github.com/mainflux/mfxkit/mfxkit/sqldb"
+	"github.com/stretchr/testify/require"
+)
+
+// envPostgresHost enables the tests against the PostgreSQL server running
+// on the given host, using the default database credentials of the service.
+// The kits table of the test database is emptied by each test.
+const envPostgresHost = "MF_MFXKIT_TEST_POSTGRES_HOST"
+
+func TestSQLiteKitRepository(t *testing.T) {
+	repotest.TestKitRepository(t, func(t *testing.T) mfxkit.KitRepository {
+		db := connect(t, sqldb.Config{
+			Type: sqldb.SQLite,
+			Name: filepath.Join(t.TempDir(), "mfxkit.db"),
+		})
+
+		return sqldb.NewKitRepository(db)
+	})
+}
+
+func TestPostgresKitRepository(t *testing.T) {
+	host := os.Getenv(envPostgresHost)
+	if host == "" {
+		t.Skipf("%s is not set", envPostgresHost)
+	}
+
+	repotest.TestKitRepository(t, func(t *testing.T) mfxkit.KitRepository {
+		db := connect(t, sqldb.Config{
+			Type:    sqldb.Postgres,
+			Host:    host,
+			Port:    "5432",
+			User:    "mainflux",
+			Pass:    "mainflux",
+			Name:    "mfxkit",
+			SSLMode: "disable",
+		})
+
+		_, err := db.Exec(`DELETE FROM kits`)
+		require.Nil(t, err, fmt.Sprintf("empty kits table: unexpected error: %s", err))
+
+		return sqldb.NewKitRepository(db)
+	})
+}
+
+func connect(t *testing.T, cfg sqldb.Config) *sql.DB {
+	db, err := sqldb.Connect(cfg)
+	require.Nil(t, err, fmt.Sprintf("connect to database: unexpected error: %s", err))
+	t.Cleanup(func() { db.Close() })
+
+	_, err = sqldb.Migrate(db)
+	require.Nil(t, err, fmt.Sprintf("migrate database: unexpected error: %s", err))
+
+	return db
+}
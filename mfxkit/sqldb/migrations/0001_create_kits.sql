@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS kits (
+    id         VARCHAR(254) PRIMARY KEY,
+    owner      VARCHAR(254) NOT NULL,
+    name       VARCHAR(1024) NOT NULL DEFAULT '',
+    metadata   TEXT,
+    revision   BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    deleted_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS kits_owner_idx ON kits (owner);
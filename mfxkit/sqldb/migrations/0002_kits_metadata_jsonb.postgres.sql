@@ -0,0 +1,3 @@
+ALTER TABLE kits ALTER COLUMN metadata TYPE JSONB USING metadata::JSONB;
+
+CREATE INDEX IF NOT EXISTS kits_metadata_idx ON kits USING GIN (metadata jsonb_path_ops);
@@ -0,0 +1,122 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mfxkit
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"runtime"
+	"sync"
+)
+
+// maxVerified bounds the number of remembered tokens, which are forgotten
+// all at once when the bound is reached.
+const maxVerified = 1024
+
+type digest [sha256.Size]byte
+
+// verifier compares tokens with the secret hashes. Hashing is slow on
+// purpose, so the tokens that matched a hash are remembered by their keyed
+// digest, and the number of concurrent comparisons is limited so that
+// invalid tokens cannot exhaust the service CPU and memory.
+type verifier struct {
+	hasher   Hasher
+	key      []byte
+	sem      chan struct{}
+	mu       sync.Mutex
+	verified map[digest]string
+}
+
+func newVerifier(hasher Hasher) *verifier {
+	v := &verifier{
+		hasher:   hasher,
+		sem:      make(chan struct{}, runtime.NumCPU()),
+		verified: map[digest]string{},
+	}
+
+	// Without the key, tokens are not remembered and every token is
+	// compared with the hashes.
+	key := make([]byte, sha256.Size)
+	if _, err := rand.Read(key); err == nil {
+		v.key = key
+	}
+
+	return v
+}
+
+// verify returns the first of the secrets whose hash matches the token.
+// Only the currently valid secrets must be passed, so that the remembered
+// tokens of the secrets that are no longer valid are not accepted.
+func (v *verifier) verify(ctx context.Context, token string, secrets []Secret) (Secret, error) {
+	d := v.digest(token)
+	if s, ok := v.lookup(d, secrets); ok {
+		return s, nil
+	}
+
+	select {
+	case v.sem <- struct{}{}:
+		defer func() { <-v.sem }()
+	case <-ctx.Done():
+		return Secret{}, ctx.Err()
+	}
+
+	for _, s := range secrets {
+		if err := v.hasher.Compare(token, s.Hash); err == nil {
+			v.remember(d, s.Hash)
+			return s, nil
+		}
+	}
+
+	return Secret{}, ErrUnauthorizedAccess
+}
+
+func (v *verifier) digest(token string) digest {
+	var d digest
+	if v.key == nil {
+		return d
+	}
+
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write([]byte(token))
+	copy(d[:], mac.Sum(nil))
+
+	return d
+}
+
+func (v *verifier) lookup(d digest, secrets []Secret) (Secret, bool) {
+	if v.key == nil {
+		return Secret{}, false
+	}
+
+	v.mu.Lock()
+	hash, ok := v.verified[d]
+	v.mu.Unlock()
+	if !ok {
+		return Secret{}, false
+	}
+
+	for _, s := range secrets {
+		if s.Hash == hash {
+			return s, true
+		}
+	}
+
+	return Secret{}, false
+}
+
+func (v *verifier) remember(d digest, hash string) {
+	if v.key == nil {
+		return
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if len(v.verified) >= maxVerified {
+		v.verified = map[digest]string{}
+	}
+	v.verified[d] = hash
+}
@@ -0,0 +1,189 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package httpgen
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+)
+
+// ErrInvalidAnnotation indicates malformed method annotation.
+var ErrInvalidAnnotation = errors.New("invalid annotation")
+
+type source int
+
+const (
+	bodySource source = iota
+	pathSource
+	querySource
+	headerSource
+)
+
+// route contains the annotations of a method.
+type route struct {
+	method   mwgen.Method
+	verb     string
+	path     string
+	status   int
+	params   map[string]*param
+	required map[string]bool
+}
+
+type param struct {
+	mwgen.Param
+	source source
+	key    string
+	def    string
+	auth   bool
+}
+
+var verbs = map[string]string{
+	http.MethodGet:    "Get",
+	http.MethodPost:   "Post",
+	http.MethodPut:    "Put",
+	http.MethodPatch:  "Patch",
+	http.MethodDelete: "Delete",
+}
+
+// parseRoute returns the route of the method, or nil if the method is not
+// annotated.
+func parseRoute(m mwgen.Method) (*route, error) {
+	rt := &route{
+		method:   m,
+		params:   map[string]*param{},
+		required: map[string]bool{},
+	}
+	for _, p := range m.Params {
+		if p.IsContext() {
+			continue
+		}
+		rt.params[p.Name] = &param{Param: p, source: bodySource, key: mwgen.SnakeCase(p.Name)}
+	}
+
+	annotated := false
+	for _, line := range m.Doc {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "@") {
+			continue
+		}
+
+		if err := rt.annotate(fields[0], fields[1:]); err != nil {
+			return nil, fmt.Errorf("%w: %s: %q: %s", ErrInvalidAnnotation, m.Name, line, err)
+		}
+		annotated = annotated || fields[0] == "@http"
+	}
+
+	if !annotated {
+		return nil, nil
+	}
+
+	for _, seg := range strings.Split(rt.path, "/") {
+		if !strings.HasPrefix(seg, ":") {
+			continue
+		}
+		p, ok := rt.params[seg[1:]]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s: unknown path parameter %s", ErrInvalidAnnotation, m.Name, seg)
+		}
+		p.source = pathSource
+		rt.required[p.Name] = true
+	}
+
+	if rt.status == 0 {
+		rt.status = http.StatusOK
+		if len(rt.results()) == 0 {
+			rt.status = http.StatusNoContent
+		}
+	}
+
+	return rt, nil
+}
+
+func (rt *route) annotate(name string, args []string) error {
+	switch name {
+	case "@http":
+		if len(args) != 2 {
+			return errors.New("expected method and path")
+		}
+		if _, ok := verbs[args[0]]; !ok {
+			return fmt.Errorf("unsupported method %s", args[0])
+		}
+		rt.verb, rt.path = args[0], args[1]
+	case "@auth":
+		p, err := rt.param(args, 1)
+		if err != nil {
+			return err
+		}
+		p.source, p.key, p.auth = headerSource, "Authorization", true
+	case "@header":
+		p, err := rt.param(args, 2)
+		if err != nil {
+			return err
+		}
+		p.source, p.key = headerSource, args[1]
+	case "@query":
+		if len(args) != 1 {
+			return errors.New("expected parameter")
+		}
+		kv := strings.SplitN(args[0], "=", 2)
+		p, err := rt.param(kv[:1], 1)
+		if err != nil {
+			return err
+		}
+		p.source = querySource
+		if len(kv) == 2 {
+			p.def = kv[1]
+		}
+	case "@required":
+		for _, a := range args {
+			if _, ok := rt.params[a]; !ok {
+				return fmt.Errorf("unknown parameter %s", a)
+			}
+			rt.required[a] = true
+		}
+	case "@status":
+		if len(args) != 1 {
+			return errors.New("expected status code")
+		}
+		code, err := strconv.Atoi(args[0])
+		if err != nil || http.StatusText(code) == "" {
+			return fmt.Errorf("invalid status code %s", args[0])
+		}
+		rt.status = code
+	default:
+		return errors.New("unknown annotation")
+	}
+
+	return nil
+}
+
+func (rt *route) param(args []string, n int) (*param, error) {
+	if len(args) != n {
+		return nil, fmt.Errorf("expected %d arguments", n)
+	}
+
+	p, ok := rt.params[args[0]]
+	if !ok {
+		return nil, fmt.Errorf("unknown parameter %s", args[0])
+	}
+
+	return p, nil
+}
+
+// results returns the results forming the response body.
+func (rt *route) results() []mwgen.Param {
+	var res []mwgen.Param
+	for _, r := range rt.method.Results {
+		if !r.IsError() {
+			res = append(res, r)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,25 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package httpgen generates the HTTP transport of the service methods
+// annotated in the service interface. Annotations are the lines of the
+// method doc comment starting with @:
+//
+//	@http <method> <path>      HTTP method and bone path, e.g. GET /kits/:id
+//	@auth <param>              token read from Authorization header
+//	@header <param> <name>     parameter read from the named header
+//	@query <param>[=<default>] parameter read from the query
+//	@required <param>...       parameters that must not be empty
+//	@status <code>             status code of successful responses
+//
+// Path parameters are matched with the method parameters by name, and all
+// the other parameters are decoded from the JSON request body. Named results
+// form the JSON response body.
+//
+// The generated code relies on the contentType constant, the
+// errUnsupportedContentType error and the authToken, readUintQuery,
+// readStringQuery and readBoolQuery helpers declared by the transport
+// package, and on
+// ErrMalformedEntity and ErrUnauthorizedAccess declared by the service
+// package.
+package httpgen
@@ -0,0 +1,331 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package httpgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"net/http"
+	"strings"
+
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+)
+
+var statuses = map[int]string{
+	http.StatusOK:        "http.StatusOK",
+	http.StatusCreated:   "http.StatusCreated",
+	http.StatusAccepted:  "http.StatusAccepted",
+	http.StatusNoContent: "http.StatusNoContent",
+}
+
+// Config contains the options of the generated code.
+type Config struct {
+	// Package is the name of the package of the generated code.
+	Package string
+
+	// BuildTags is the build constraint of the generated file, if any.
+	BuildTags string
+}
+
+// Generate returns the generated source of the HTTP transport of the
+// annotated methods of the service.
+func Generate(svc mwgen.Service, cfg Config) ([]byte, error) {
+	var routes []*route
+	for _, m := range svc.Methods {
+		rt, err := parseRoute(m)
+		if err != nil {
+			return nil, err
+		}
+		if rt != nil {
+			routes = append(routes, rt)
+		}
+	}
+
+	g := generator{
+		svc:     svc,
+		imports: map[string]string{},
+	}
+	for _, rt := range routes {
+		if err := g.route(rt); err != nil {
+			return nil, err
+		}
+	}
+	g.register(routes)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Copyright (c) Mainflux\n// SPDX-License-Identifier: Apache-2.0\n\n")
+	buf.WriteString("// Code generated by httpgen. DO NOT EDIT.\n\n")
+	if cfg.BuildTags != "" {
+		fmt.Fprintf(&buf, "// +build %s\n\n", cfg.BuildTags)
+	}
+	fmt.Fprintf(&buf, "package %s\n\n", cfg.Package)
+	buf.WriteString(mwgen.FormatImports(g.imports))
+	buf.WriteString("\n")
+	buf.Write(g.buf.Bytes())
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+
+	return src, nil
+}
+
+type generator struct {
+	svc     mwgen.Service
+	imports map[string]string
+	buf     bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) use(path, name string) {
+	g.imports[path] = name
+}
+
+func (g *generator) route(rt *route) error {
+	name := lowerFirst(rt.method.Name)
+	results := rt.results()
+	for _, r := range results {
+		if !r.Named() {
+			return fmt.Errorf("%w: %s: results must be named", ErrInvalidAnnotation, rt.method.Name)
+		}
+	}
+	if n := len(rt.method.Results); n == 0 || !rt.method.Results[n-1].IsError() {
+		return fmt.Errorf("%w: %s: the last result must be an error", ErrInvalidAnnotation, rt.method.Name)
+	}
+
+	g.use("context", "")
+	g.use("net/http", "")
+	g.use("github.com/go-kit/kit/endpoint", "")
+	g.use("github.com/mainflux/mainflux", "")
+	g.use(g.svc.PkgPath, "")
+	for n, path := range g.svc.Imports {
+		if strings.HasSuffix(path, "/"+n) || path == n {
+			n = ""
+		}
+		g.use(path, n)
+	}
+
+	params := rt.orderedParams()
+
+	// Request.
+	g.printf("\ntype %sReq struct {\n", name)
+	for _, p := range params {
+		typ := strings.Replace(p.Type, "...", "[]", 1)
+		if p.source == bodySource {
+			g.printf("\t%s %s `json:\"%s\"`\n", upperFirst(p.Name), typ, p.key)
+			continue
+		}
+		g.printf("\t%s %s\n", p.Name, typ)
+	}
+	g.printf("}\n\n")
+
+	g.printf("func (req %sReq) validate() error {\n", name)
+	for _, p := range params {
+		if p.auth {
+			g.printf("\tif %s {\n\t\treturn %s.ErrUnauthorizedAccess\n\t}\n\n", isEmpty("req."+field(p), p.Kind()), g.svc.PkgName)
+		}
+	}
+	for _, p := range params {
+		if !rt.required[p.Name] || p.auth {
+			continue
+		}
+		empty := isEmpty("req."+field(p), p.Kind())
+		if empty == "" {
+			return fmt.Errorf("%w: %s: %s can not be required", ErrInvalidAnnotation, rt.method.Name, p.Name)
+		}
+		g.printf("\tif %s {\n\t\treturn %s.ErrMalformedEntity\n\t}\n\n", empty, g.svc.PkgName)
+	}
+	g.printf("\treturn nil\n}\n")
+
+	// Response.
+	status, ok := statuses[rt.status]
+	if !ok {
+		status = fmt.Sprint(rt.status)
+	}
+	g.printf("\nvar _ mainflux.Response = (*%sRes)(nil)\n\n", name)
+	g.printf("type %sRes struct {\n", name)
+	for _, r := range results {
+		g.printf("\t%s %s `json:\"%s\"`\n", upperFirst(r.Name), r.Type, mwgen.SnakeCase(r.Name))
+	}
+	g.printf("}\n\n")
+	g.printf("func (res %sRes) Code() int {\n\treturn %s\n}\n\n", name, status)
+	g.printf("func (res %sRes) Headers() map[string]string {\n\treturn map[string]string{}\n}\n\n", name)
+	g.printf("func (res %sRes) Empty() bool {\n\treturn %t\n}\n", name, len(results) == 0)
+
+	// Endpoint.
+	args := []string{"ctx"}
+	for i, p := range rt.method.Params {
+		if p.IsContext() {
+			continue
+		}
+		arg := "req." + field(*rt.params[p.Name])
+		if rt.method.Variadic && i == len(rt.method.Params)-1 {
+			arg += "..."
+		}
+		args = append(args, arg)
+	}
+	call := fmt.Sprintf("svc.%s(%s)", rt.method.Name, strings.Join(args, ", "))
+
+	g.printf("\nfunc %sEndpoint(svc %s.%s) endpoint.Endpoint {\n", name, g.svc.PkgName, g.svc.Name)
+	g.printf("\treturn func(ctx context.Context, request interface{}) (interface{}, error) {\n")
+	g.printf("\t\treq := request.(%sReq)\n\n", name)
+	g.printf("\t\tif err := req.validate(); err != nil {\n\t\t\treturn nil, err\n\t\t}\n\n")
+	if len(results) == 0 {
+		g.printf("\t\tif err := %s; err != nil {\n\t\t\treturn nil, err\n\t\t}\n\n", call)
+		g.printf("\t\treturn %sRes{}, nil\n\t}\n}\n", name)
+	} else {
+		var vars []string
+		for _, r := range results {
+			vars = append(vars, r.Name)
+		}
+		g.printf("\t\t%s, err := %s\n", strings.Join(vars, ", "), call)
+		g.printf("\t\tif err != nil {\n\t\t\treturn nil, err\n\t\t}\n\n")
+		g.printf("\t\tres := %sRes{\n", name)
+		for _, r := range results {
+			g.printf("\t\t\t%s: %s,\n", upperFirst(r.Name), r.Name)
+		}
+		g.printf("\t\t}\n\t\treturn res, nil\n\t}\n}\n")
+	}
+
+	// Decoder.
+	g.printf("\nfunc decode%s(_ context.Context, r *http.Request) (interface{}, error) {\n", rt.method.Name)
+	body := false
+	for _, p := range params {
+		body = body || p.source == bodySource
+	}
+	if body {
+		g.use("encoding/json", "")
+		g.use("strings", "")
+		g.printf("\tif !strings.Contains(r.Header.Get(\"Content-Type\"), contentType) {\n\t\treturn nil, errUnsupportedContentType\n\t}\n\n")
+	}
+
+	var fields []string
+	for _, p := range params {
+		switch p.source {
+		case pathSource:
+			g.use("github.com/go-zoo/bone", "")
+			fields = append(fields, fmt.Sprintf("%s: bone.GetValue(r, %q)", p.Name, p.Name))
+		case headerSource:
+			if p.auth {
+				fields = append(fields, fmt.Sprintf("%s: authToken(r)", p.Name))
+				continue
+			}
+			fields = append(fields, fmt.Sprintf("%s: r.Header.Get(%q)", p.Name, p.key))
+		case querySource:
+			read, err := readQuery(p)
+			if err != nil {
+				return fmt.Errorf("%w: %s: %s", ErrInvalidAnnotation, rt.method.Name, err)
+			}
+			g.printf("\t%s, err := %s\n\tif err != nil {\n\t\treturn nil, err\n\t}\n", p.Name, read)
+			if p.Type == "string" && p.def != "" {
+				g.printf("\tif %s == \"\" {\n\t\t%s = %q\n\t}\n", p.Name, p.Name, p.def)
+			}
+			g.printf("\n")
+			fields = append(fields, fmt.Sprintf("%s: %s", p.Name, p.Name))
+		}
+	}
+
+	if len(fields) == 0 {
+		g.printf("\treq := %sReq{}\n", name)
+	} else {
+		g.printf("\treq := %sReq{\n\t\t%s,\n\t}\n", name, strings.Join(fields, ",\n\t\t"))
+	}
+	if body {
+		g.printf("\tif err := json.NewDecoder(r.Body).Decode(&req); err != nil {\n\t\treturn nil, err\n\t}\n")
+	}
+	g.printf("\n\treturn req, nil\n}\n")
+
+	return nil
+}
+
+// register emits the function registering the routes.
+func (g *generator) register(routes []*route) {
+	g.use("github.com/go-zoo/bone", "")
+	g.use("github.com/opentracing/opentracing-go", "opentracing")
+	g.use("github.com/go-kit/kit/transport/http", "kithttp")
+	g.use(g.svc.PkgPath, "")
+	if len(routes) > 0 {
+		g.use("github.com/go-kit/kit/tracing/opentracing", "kitot")
+	}
+
+	g.printf("\n// registerRoutes registers the routes of the annotated service methods.\n")
+	g.printf("func registerRoutes(r *bone.Mux, tracer opentracing.Tracer, svc %s.%s, opts ...kithttp.ServerOption) {\n", g.svc.PkgName, g.svc.Name)
+	for i, rt := range routes {
+		if i > 0 {
+			g.printf("\n")
+		}
+		g.printf("\tr.%s(%q, kithttp.NewServer(\n", verbs[rt.verb], rt.path)
+		g.printf("\t\tkitot.TraceServer(tracer, %q)(%sEndpoint(svc)),\n", mwgen.SnakeCase(rt.method.Name), lowerFirst(rt.method.Name))
+		g.printf("\t\tdecode%s,\n\t\tencodeResponse,\n\t\topts...,\n\t))\n", rt.method.Name)
+	}
+	g.printf("}\n")
+}
+
+// orderedParams returns the non-context parameters in the declaration order.
+func (rt *route) orderedParams() []param {
+	var params []param
+	for _, p := range rt.method.Params {
+		if p.IsContext() {
+			continue
+		}
+		params = append(params, *rt.params[p.Name])
+	}
+
+	return params
+}
+
+func readQuery(p param) (string, error) {
+	switch p.Type {
+	case "uint64":
+		def := p.def
+		if def == "" {
+			def = "0"
+		}
+		return fmt.Sprintf("readUintQuery(r, %q, %s)", p.key, def), nil
+	case "bool":
+		def := p.def
+		if def == "" {
+			def = "false"
+		}
+		return fmt.Sprintf("readBoolQuery(r, %q, %s)", p.key, def), nil
+	case "string":
+		return fmt.Sprintf("readStringQuery(r, %q)", p.key), nil
+	}
+
+	return "", fmt.Errorf("unsupported query parameter type %s", p.Type)
+}
+
+func isEmpty(expr, kind string) string {
+	switch kind {
+	case "string":
+		return fmt.Sprintf("%s == \"\"", expr)
+	case "int", "float":
+		return fmt.Sprintf("%s == 0", expr)
+	case "slice", "map":
+		return fmt.Sprintf("len(%s) == 0", expr)
+	}
+
+	return ""
+}
+
+func field(p param) string {
+	if p.source == bodySource {
+		return upperFirst(p.Name)
+	}
+
+	return p.Name
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+func upperFirst(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
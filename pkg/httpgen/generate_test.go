@@ -0,0 +1,37 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package httpgen_test
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/mainflux/mfxkit/pkg/httpgen"
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestGenerate(t *testing.T) {
+	svc, err := mwgen.Parse("./testdata/counter", "Service")
+	require.Nil(t, err, fmt.Sprintf("parse service: unexpected error: %s", err))
+
+	code, err := httpgen.Generate(svc, httpgen.Config{Package: "http"})
+	require.Nil(t, err, fmt.Sprintf("generate routes: unexpected error: %s", err))
+
+	golden := filepath.Join("testdata", "counter", "routes.golden")
+	if *update {
+		err := ioutil.WriteFile(golden, code, 0644)
+		require.Nil(t, err, fmt.Sprintf("update golden file: unexpected error: %s", err))
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	require.Nil(t, err, fmt.Sprintf("read golden file: unexpected error: %s", err))
+	assert.Equal(t, string(expected), string(code), "generated routes: expected the golden file, run go test -update to refresh it")
+}
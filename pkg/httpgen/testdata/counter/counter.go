@@ -0,0 +1,39 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package counter declares the service used by the generator tests.
+package counter
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	ErrMalformedEntity    = errors.New("malformed entity")
+	ErrUnauthorizedAccess = errors.New("unauthorized access")
+)
+
+// Service counts the things of the owners.
+type Service interface {
+	// CountThings returns the number of things.
+	//
+	// @http GET /things/:owner/count
+	// @auth token
+	// @query name
+	// @query limit=10
+	// @query deleted
+	// @required owner
+	CountThings(ctx context.Context, token, owner, name string, limit uint64, deleted bool) (count uint64, err error)
+
+	// RenameThing renames the thing.
+	//
+	// @http PUT /things/:id/name
+	// @auth token
+	// @header channel Channel-ID
+	// @required name
+	RenameThing(ctx context.Context, token, channel, id, name string) error
+
+	// ResetThings is not exposed over HTTP.
+	ResetThings(ctx context.Context) error
+}
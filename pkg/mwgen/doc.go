@@ -0,0 +1,7 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mwgen generates the logging, metrics and tracing middlewares of a
+// service interface. It is used by the mwgen command, which is meant to be
+// run using go:generate from the package the middlewares belong to.
+package mwgen
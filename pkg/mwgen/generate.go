@@ -0,0 +1,447 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mwgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const redacted = "[REDACTED]"
+
+// DefRedacted lists the default suffixes of names of the parameters and
+// fields whose values must not be logged or traced.
+var DefRedacted = []string{"secret", "token", "password", "key"}
+
+// Config contains the options of the generated code.
+type Config struct {
+	// Package is the name of the package of the generated code.
+	Package string
+
+	// BuildTags is the build constraint of the generated files, if any.
+	BuildTags string
+
+	// Redacted lists case-insensitive suffixes of names of the parameters
+	// and struct fields whose values are redacted.
+	Redacted []string
+
+	// Labels are logged, traced and used as the request counter labels.
+	Labels []Label
+
+	// Skipped lists the struct fields, given as Type.Field, that are
+	// neither logged nor traced, e.g. the ones set only by the service.
+	Skipped []string
+}
+
+// Label is a value determined by the service while handling the call, e.g.
+// the ID of the key that identified the caller. Func names the function of
+// the service package that tracks the value using the call context, with
+// the signature:
+//
+//	func(context.Context) (context.Context, func() string)
+//
+// It returns the context passed to the service and the function returning
+// the tracked value once the call has returned. It must return the context
+// that already tracks the value unchanged, since every middleware tracks
+// it. Methods without the context parameter use the empty value, as well
+// as the methods without a parameter named like one of the Params suffixes,
+// if any.
+type Label struct {
+	Name   string
+	Func   string
+	Params []string
+}
+
+// Generate returns the generated sources of the logging, metrics and tracing
+// middlewares of the service, mapped by their file names.
+func Generate(svc Service, cfg Config) (map[string][]byte, error) {
+	v := newView(svc, cfg)
+
+	files := map[string][]byte{}
+	for name, tpl := range map[string]*template.Template{
+		"logging.go": loggingTpl,
+		"metrics.go": metricsTpl,
+		"tracing.go": tracingTpl,
+	} {
+		var buf bytes.Buffer
+		if err := tpl.Execute(&buf, v); err != nil {
+			return nil, err
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		files[name] = src
+	}
+
+	return files, nil
+}
+
+type view struct {
+	Package string
+	// Labels enumerates the names of the request counter labels other
+	// than the method.
+	Labels    string
+	BuildTags string
+	PkgName   string
+	PkgPath   string
+	Service   string
+	imports   map[string]string
+	Errors    []string
+	Methods   []methodView
+	Failed    []failedView
+}
+
+type methodView struct {
+	Name    string
+	Op      string
+	Params  string
+	Results string
+	// Named are the results named so that they can be accessed in the
+	// deferred functions.
+	Named string
+	Call  string
+	Err   string
+	Ctx   string
+	// Format and Args form the log message.
+	Format string
+	Args   string
+	Items  string
+	Tags   []tagView
+	// Track lists the labels tracked by the method, and LabelValues
+	// the request counter label values that follow the method name.
+	Track       []trackView
+	LabelValues string
+}
+
+type trackView struct {
+	Name string
+	Ctx  string
+	Var  string
+	Func string
+}
+
+type tagView struct {
+	Key   string
+	Value string
+}
+
+type failedView struct {
+	Func  string
+	Type  string
+	Field string
+}
+
+func newView(svc Service, cfg Config) view {
+	v := view{
+		Package:   cfg.Package,
+		BuildTags: cfg.BuildTags,
+		PkgName:   svc.PkgName,
+		PkgPath:   svc.PkgPath,
+		Service:   fmt.Sprintf("%s.%s", svc.PkgName, svc.Name),
+		Errors:    svc.Errors,
+	}
+
+	var labels []string
+	for _, l := range cfg.Labels {
+		labels = append(labels, l.Name)
+	}
+	if len(labels) > 0 {
+		v.Labels = join(labels)
+	}
+
+	v.imports = map[string]string{svc.PkgPath: ""}
+	for name, path := range svc.Imports {
+		if name == path[strings.LastIndex(path, "/")+1:] {
+			name = ""
+		}
+		v.imports[path] = name
+	}
+
+	failed := map[string]failedView{}
+	for _, m := range svc.Methods {
+		v.Methods = append(v.Methods, newMethodView(m, svc.PkgName, cfg, failed))
+	}
+	for _, f := range failed {
+		v.Failed = append(v.Failed, f)
+	}
+	sort.Slice(v.Failed, func(i, j int) bool { return v.Failed[i].Func < v.Failed[j].Func })
+
+	return v
+}
+
+// Imports returns the import declaration of the packages used by the method
+// signatures and the given ones, formatted as "path" or "name path".
+func (v view) Imports(specs ...string) string {
+	imports := map[string]string{}
+	for path, name := range v.imports {
+		imports[path] = name
+	}
+	for _, s := range specs {
+		fields := strings.Fields(s)
+		if len(fields) == 2 {
+			imports[fields[1]] = fields[0]
+			continue
+		}
+		imports[fields[0]] = ""
+	}
+
+	return FormatImports(imports)
+}
+
+// FormatImports returns the import declaration of the packages, given as
+// a map of import paths to package names. Empty names are omitted.
+func FormatImports(imports map[string]string) string {
+	var std, other []string
+	for path, name := range imports {
+		spec := fmt.Sprintf("%q", path)
+		if name != "" {
+			spec = fmt.Sprintf("%s %q", name, path)
+		}
+		if strings.Contains(strings.Split(path, "/")[0], ".") {
+			other = append(other, spec)
+			continue
+		}
+		std = append(std, spec)
+	}
+	sort.Strings(std)
+	sort.Strings(other)
+
+	groups := []string{}
+	for _, g := range [][]string{std, other} {
+		if len(g) > 0 {
+			groups = append(groups, "\t"+strings.Join(g, "\n\t"))
+		}
+	}
+
+	return fmt.Sprintf("import (\n%s\n)", strings.Join(groups, "\n\n"))
+}
+
+func newMethodView(m Method, pkgName string, cfg Config, failed map[string]failedView) methodView {
+	redact := cfg.Redacted
+	mv := methodView{
+		Name: m.Name,
+		Op:   SnakeCase(m.Name),
+		Err:  "nil",
+	}
+
+	var params, call, parts, args []string
+	for i, p := range m.Params {
+		params = append(params, fmt.Sprintf("%s %s", p.Name, p.Type))
+		arg := p.Name
+		if m.Variadic && i == len(m.Params)-1 {
+			arg += "..."
+		}
+		call = append(call, arg)
+
+		if p.kind == contextKind {
+			if mv.Ctx == "" {
+				mv.Ctx = p.Name
+			}
+			continue
+		}
+		if matches(p.Name, redact) {
+			parts = append(parts, fmt.Sprintf("%s %s", p.Name, redacted))
+			continue
+		}
+
+		switch p.kind {
+		case stringKind, intKind, boolKind, floatKind:
+			parts = append(parts, fmt.Sprintf("%s %s", p.Name, verb(p.kind)))
+			args = append(args, p.Name)
+			mv.Tags = append(mv.Tags, tagView{Key: p.Name, Value: p.Name})
+		case sliceKind:
+			parts = append(parts, fmt.Sprintf("%%d %s", p.Name))
+			args = append(args, fmt.Sprintf("len(%s)", p.Name))
+			mv.Tags = append(mv.Tags, tagView{Key: p.Name + ".count", Value: fmt.Sprintf("len(%s)", p.Name)})
+			if mv.Items == "" {
+				mv.Items = p.Name
+			}
+		case mapKind:
+			parts = append(parts, fmt.Sprintf("%s with %%d entries", p.Name))
+			args = append(args, fmt.Sprintf("len(%s)", p.Name))
+		case structKind:
+			if len(p.fields) == 0 {
+				continue
+			}
+			typ := strings.TrimPrefix(p.Type, "*")
+			typ = typ[strings.LastIndex(typ, ".")+1:]
+			var fields []string
+			for _, f := range p.fields {
+				if isSkipped(typ, f.name, cfg.Skipped) {
+					continue
+				}
+				if matches(f.name, redact) {
+					fields = append(fields, fmt.Sprintf("%s: %s", f.name, redacted))
+					continue
+				}
+				val := fmt.Sprintf("%s.%s", p.Name, f.name)
+				fields = append(fields, fmt.Sprintf("%s: %s", f.name, verb(f.kind)))
+				args = append(args, val)
+				mv.Tags = append(mv.Tags, tagView{Key: fmt.Sprintf("%s.%s", p.Name, SnakeCase(f.name)), Value: val})
+			}
+			parts = append(parts, fmt.Sprintf("%s {%s}", p.Name, strings.Join(fields, ", ")))
+		}
+	}
+
+	values := 0
+	for _, r := range m.Results {
+		if r.kind != errorKind {
+			values++
+		}
+	}
+
+	var results, named []string
+	for i, r := range m.Results {
+		results = append(results, r.Type)
+
+		name := r.Name
+		switch {
+		case r.auto && r.kind == errorKind:
+			name = "err"
+		case r.auto && values == 1:
+			name = "res"
+		case r.auto:
+			name = fmt.Sprintf("res%d", i)
+		}
+		named = append(named, fmt.Sprintf("%s %s", name, r.Type))
+		if r.kind == errorKind {
+			mv.Err = name
+		}
+
+		if r.kind == sliceKind && r.errField != "" {
+			elem := strings.TrimPrefix(r.Type, "[]")
+			f := failedView{
+				Func:  "failed" + elem[strings.LastIndex(elem, ".")+1:],
+				Type:  r.Type,
+				Field: r.errField,
+			}
+			failed[f.Func] = f
+			parts = append(parts, "%d failed")
+			args = append(args, fmt.Sprintf("%s(%s)", f.Func, name))
+		}
+	}
+
+	for _, l := range cfg.Labels {
+		if mv.Ctx == "" || !hasParam(m, l.Params) {
+			mv.LabelValues += fmt.Sprintf(", %q, %q", l.Name, "")
+			continue
+		}
+
+		t := trackView{
+			Name: l.Name,
+			Ctx:  mv.Ctx,
+			Var:  camelCase(l.Name) + "Label",
+			Func: fmt.Sprintf("%s.%s", pkgName, l.Func),
+		}
+		mv.Track = append(mv.Track, t)
+		mv.LabelValues += fmt.Sprintf(", %q, %s()", l.Name, t.Var)
+		parts = append(parts, fmt.Sprintf("%s %%s", l.Name))
+		args = append(args, t.Var+"()")
+	}
+
+	mv.Params = strings.Join(params, ", ")
+	mv.Call = strings.Join(call, ", ")
+	mv.Results = strings.Join(results, ", ")
+	mv.Named = strings.Join(named, ", ")
+	if len(results) > 1 {
+		mv.Results = "(" + mv.Results + ")"
+	}
+
+	mv.Format = fmt.Sprintf("Method %s", mv.Op)
+	if len(parts) > 0 {
+		mv.Format += " for " + join(parts)
+	}
+	mv.Format += " took %s to complete"
+	if len(args) > 0 {
+		mv.Args = strings.Join(args, ", ") + ", "
+	}
+
+	return mv
+}
+
+func verb(k kind) string {
+	switch k {
+	case intKind:
+		return "%d"
+	case boolKind:
+		return "%t"
+	case floatKind:
+		return "%g"
+	}
+
+	return "%s"
+}
+
+// matches reports whether the name ends with one of the case-insensitive
+// suffixes.
+func matches(name string, suffixes []string) bool {
+	name = strings.ToLower(name)
+	for _, s := range suffixes {
+		if strings.HasSuffix(name, strings.ToLower(s)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasParam reports whether the method has a parameter named like one of the
+// suffixes. Every method matches the empty list.
+func hasParam(m Method, suffixes []string) bool {
+	if len(suffixes) == 0 {
+		return true
+	}
+	for _, p := range m.Params {
+		if p.kind != contextKind && matches(p.Name, suffixes) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSkipped(typ, field string, skipped []string) bool {
+	for _, s := range skipped {
+		if s == typ+"."+field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// camelCase converts the snake case name to lower camel case, keeping the
+// common initialisms upper case, e.g. key_id to keyID.
+func camelCase(name string) string {
+	words := strings.Split(name, "_")
+	for i, w := range words {
+		switch {
+		case i == 0:
+			words[i] = strings.ToLower(w)
+		case initialisms[strings.ToLower(w)]:
+			words[i] = strings.ToUpper(w)
+		case w != "":
+			words[i] = strings.ToUpper(w[:1]) + strings.ToLower(w[1:])
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+var initialisms = map[string]bool{"id": true, "ip": true, "url": true, "uri": true, "http": true, "json": true, "api": true}
+
+// join joins the parts as an enumeration, e.g. "a, b and c".
+func join(parts []string) string {
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
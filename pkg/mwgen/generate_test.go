@@ -0,0 +1,47 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mwgen_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/mainflux/mfxkit/pkg/mwgen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	svc, err := mwgen.Parse("./testdata/things", "Service")
+	require.Nil(t, err, fmt.Sprintf("parse service: unexpected error: %s", err))
+
+	cfg := mwgen.Config{
+		Package:  "api",
+		Redacted: mwgen.DefRedacted,
+		Labels:   []mwgen.Label{{Name: "key_id", Func: "TrackKeyID", Params: []string{"token"}}},
+		Skipped:  []string{"Thing.Owner"},
+	}
+	files, err := mwgen.Generate(svc, cfg)
+	require.Nil(t, err, fmt.Sprintf("generate middlewares: unexpected error: %s", err))
+
+	cases := []struct {
+		desc     string
+		file     string
+		contains string
+		present  bool
+	}{
+		{"logged field", "logging.go", "thing {ID: %s, Name: %s}", true},
+		{"skipped field tag", "tracing.go", `"thing.owner"`, false},
+		{"traced field", "tracing.go", `span.SetTag("thing.name", thing.Name)`, true},
+		{"tracked label", "metrics.go", `"method", "update", "key_id", keyIDLabel()`, true},
+		{"untracked label", "metrics.go", `"method", "purge", "key_id", ""`, true},
+	}
+
+	for _, tc := range cases {
+		src := string(files[tc.file])
+		ok := strings.Contains(src, tc.contains)
+		assert.Equal(t, tc.present, ok, fmt.Sprintf("%s: expected %s to contain %s: %t", tc.desc, tc.file, tc.contains, tc.present))
+	}
+}
@@ -0,0 +1,466 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mwgen
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrInterfaceNotFound indicates that the package does not declare the
+// requested interface.
+var ErrInterfaceNotFound = errors.New("interface not found")
+
+type kind int
+
+const (
+	otherKind kind = iota
+	contextKind
+	errorKind
+	stringKind
+	intKind
+	boolKind
+	floatKind
+	sliceKind
+	mapKind
+	structKind
+)
+
+// Service describes the service interface the middlewares are generated for.
+type Service struct {
+	// Name of the interface.
+	Name string
+
+	// PkgName is the name of the package declaring the interface.
+	PkgName string
+
+	// PkgPath is the import path of the package declaring the interface.
+	PkgPath string
+
+	// Methods of the interface, in the declaration order.
+	Methods []Method
+
+	// Errors lists exported error variables of the package, which are
+	// expected to be returned by the service.
+	Errors []string
+
+	// Imports maps names of the packages used in method signatures to
+	// their import paths.
+	Imports map[string]string
+}
+
+// Method describes a method of the service interface.
+type Method struct {
+	Name     string
+	Params   []Param
+	Results  []Param
+	Variadic bool
+	// Doc contains the lines of the method doc comment.
+	Doc []string
+}
+
+// Param describes a method parameter or result.
+type Param struct {
+	Name string
+	Type string
+	kind kind
+	// fields lists basic fields of struct parameters.
+	fields []field
+	// errField is the name of the error field of struct elements of slices.
+	errField string
+	// auto is set if the parameter is unnamed in the interface.
+	auto bool
+}
+
+var kindNames = map[kind]string{
+	otherKind:   "other",
+	contextKind: "context",
+	errorKind:   "error",
+	stringKind:  "string",
+	intKind:     "int",
+	boolKind:    "bool",
+	floatKind:   "float",
+	sliceKind:   "slice",
+	mapKind:     "map",
+	structKind:  "struct",
+}
+
+// Kind returns the kind of the parameter type: string, int, bool, float,
+// slice, map, struct, context, error or other.
+func (p Param) Kind() string {
+	return kindNames[p.kind]
+}
+
+// Named reports whether the parameter is named in the interface.
+func (p Param) Named() bool {
+	return !p.auto
+}
+
+// IsContext reports whether the parameter is a context.Context.
+func (p Param) IsContext() bool {
+	return p.kind == contextKind
+}
+
+// IsError reports whether the parameter is an error.
+func (p Param) IsError() bool {
+	return p.kind == errorKind
+}
+
+type field struct {
+	name string
+	kind kind
+}
+
+type pkgParser struct {
+	pkgName string
+	types   map[string]ast.Expr
+	imports map[string]string
+	used    map[string]string
+}
+
+// Parse parses the package in the dir and returns the description of the
+// interface with the given name.
+func Parse(dir, name string) (Service, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+	if err != nil {
+		return Service{}, err
+	}
+
+	for _, pkg := range pkgs {
+		svc, err := parsePackage(pkg, name)
+		if err == ErrInterfaceNotFound {
+			continue
+		}
+		if err != nil {
+			return Service{}, err
+		}
+
+		if svc.PkgPath, err = importPath(dir); err != nil {
+			return Service{}, err
+		}
+		return svc, nil
+	}
+
+	return Service{}, fmt.Errorf("%w: %s", ErrInterfaceNotFound, name)
+}
+
+func parsePackage(pkg *ast.Package, name string) (Service, error) {
+	p := pkgParser{
+		pkgName: pkg.Name,
+		types:   map[string]ast.Expr{},
+		imports: map[string]string{},
+		used:    map[string]string{},
+	}
+
+	var (
+		iface *ast.InterfaceType
+		errs  []string
+	)
+	for _, f := range pkg.Files {
+		for _, imp := range f.Imports {
+			path, _ := strconv.Unquote(imp.Path.Value)
+			n := path[strings.LastIndex(path, "/")+1:]
+			if imp.Name != nil {
+				n = imp.Name.Name
+			}
+			p.imports[n] = path
+		}
+
+		for _, d := range f.Decls {
+			gd, ok := d.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, s := range gd.Specs {
+				switch s := s.(type) {
+				case *ast.TypeSpec:
+					p.types[s.Name.Name] = s.Type
+					if it, ok := s.Type.(*ast.InterfaceType); ok && s.Name.Name == name {
+						iface = it
+					}
+				case *ast.ValueSpec:
+					errs = append(errs, errorVars(s)...)
+				}
+			}
+		}
+	}
+
+	if iface == nil {
+		return Service{}, ErrInterfaceNotFound
+	}
+	sort.Strings(errs)
+
+	svc := Service{
+		Name:    name,
+		PkgName: pkg.Name,
+		Errors:  errs,
+		Imports: p.used,
+	}
+	for _, m := range iface.Methods.List {
+		ft, ok := m.Type.(*ast.FuncType)
+		if !ok || len(m.Names) == 0 {
+			return Service{}, fmt.Errorf("embedded interfaces are not supported in %s", name)
+		}
+
+		method := Method{Name: m.Names[0].Name}
+		if m.Doc != nil {
+			method.Doc = strings.Split(strings.TrimSpace(m.Doc.Text()), "\n")
+		}
+		method.Params, method.Variadic = p.fields(ft.Params, "p")
+		if ft.Results != nil {
+			method.Results, _ = p.fields(ft.Results, "r")
+		}
+		svc.Methods = append(svc.Methods, method)
+	}
+
+	return svc, nil
+}
+
+// errorVars returns the names of exported variables initialized using
+// errors.New.
+func errorVars(s *ast.ValueSpec) []string {
+	var names []string
+	for i, n := range s.Names {
+		if !n.IsExported() || i >= len(s.Values) {
+			continue
+		}
+		call, ok := s.Values[i].(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == "New" {
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == "errors" {
+				names = append(names, n.Name)
+			}
+		}
+	}
+
+	return names
+}
+
+// fields returns the parameters of the list, naming the unnamed ones using
+// the prefix.
+func (p *pkgParser) fields(list *ast.FieldList, prefix string) ([]Param, bool) {
+	var (
+		params   []Param
+		variadic bool
+	)
+	for _, f := range list.List {
+		typ := f.Type
+		if e, ok := typ.(*ast.Ellipsis); ok {
+			variadic = true
+			typ = &ast.ArrayType{Elt: e.Elt}
+		}
+
+		names := []string{""}
+		if len(f.Names) > 0 {
+			names = names[:0]
+			for _, n := range f.Names {
+				names = append(names, n.Name)
+			}
+		}
+
+		for _, n := range names {
+			auto := n == "" || n == "_"
+			if auto {
+				n = fmt.Sprintf("%s%d", prefix, len(params))
+			}
+			param := Param{
+				Name: n,
+				Type: p.typeString(f.Type),
+				kind: p.kindOf(typ),
+				auto: auto,
+			}
+			switch param.kind {
+			case structKind:
+				param.fields = p.structFields(typ)
+			case sliceKind:
+				param.errField = p.errField(typ)
+			}
+			params = append(params, param)
+		}
+	}
+
+	return params, variadic
+}
+
+// typeString returns the type expression qualified by the package name, as
+// used outside of the package.
+func (p *pkgParser) typeString(e ast.Expr) string {
+	switch e := e.(type) {
+	case *ast.Ident:
+		if _, ok := p.types[e.Name]; ok {
+			return fmt.Sprintf("%s.%s", p.pkgName, e.Name)
+		}
+		return e.Name
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok {
+			p.used[x.Name] = p.imports[x.Name]
+		}
+		return fmt.Sprintf("%s.%s", p.typeString(e.X), e.Sel.Name)
+	case *ast.StarExpr:
+		return "*" + p.typeString(e.X)
+	case *ast.Ellipsis:
+		return "..." + p.typeString(e.Elt)
+	case *ast.ArrayType:
+		if e.Len != nil {
+			return fmt.Sprintf("[%s]%s", p.typeString(e.Len), p.typeString(e.Elt))
+		}
+		return "[]" + p.typeString(e.Elt)
+	case *ast.MapType:
+		return fmt.Sprintf("map[%s]%s", p.typeString(e.Key), p.typeString(e.Value))
+	case *ast.InterfaceType:
+		return "interface{}"
+	case *ast.BasicLit:
+		return e.Value
+	case *ast.ChanType:
+		return "chan " + p.typeString(e.Value)
+	}
+
+	return "interface{}"
+}
+
+func (p *pkgParser) kindOf(e ast.Expr) kind {
+	switch e := e.(type) {
+	case *ast.Ident:
+		switch e.Name {
+		case "string":
+			return stringKind
+		case "bool":
+			return boolKind
+		case "int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "byte", "rune":
+			return intKind
+		case "float32", "float64":
+			return floatKind
+		case "error":
+			return errorKind
+		}
+		if t, ok := p.types[e.Name]; ok {
+			if _, ok := t.(*ast.StructType); ok {
+				return structKind
+			}
+			return p.kindOf(t)
+		}
+	case *ast.SelectorExpr:
+		if x, ok := e.X.(*ast.Ident); ok && x.Name == "context" && e.Sel.Name == "Context" {
+			return contextKind
+		}
+	case *ast.ArrayType:
+		if e.Len == nil {
+			return sliceKind
+		}
+	case *ast.MapType:
+		return mapKind
+	case *ast.StructType:
+		return structKind
+	}
+
+	return otherKind
+}
+
+// structFields returns the exported fields of basic types of the struct
+// declared in the package.
+func (p *pkgParser) structFields(e ast.Expr) []field {
+	st := p.structType(e)
+	if st == nil {
+		return nil
+	}
+
+	var fields []field
+	for _, f := range st.Fields.List {
+		k := p.kindOf(f.Type)
+		if k != stringKind && k != intKind && k != boolKind && k != floatKind {
+			continue
+		}
+		for _, n := range f.Names {
+			if n.IsExported() {
+				fields = append(fields, field{name: n.Name, kind: k})
+			}
+		}
+	}
+
+	return fields
+}
+
+// errField returns the name of the error field of the slice elements.
+func (p *pkgParser) errField(e ast.Expr) string {
+	at, ok := e.(*ast.ArrayType)
+	if !ok {
+		return ""
+	}
+
+	st := p.structType(at.Elt)
+	if st == nil {
+		return ""
+	}
+
+	for _, f := range st.Fields.List {
+		if p.kindOf(f.Type) != errorKind {
+			continue
+		}
+		for _, n := range f.Names {
+			if n.IsExported() {
+				return n.Name
+			}
+		}
+	}
+
+	return ""
+}
+
+func (p *pkgParser) structType(e ast.Expr) *ast.StructType {
+	switch e := e.(type) {
+	case *ast.StructType:
+		return e
+	case *ast.Ident:
+		if t, ok := p.types[e.Name]; ok {
+			return p.structType(t)
+		}
+	}
+
+	return nil
+}
+
+// importPath returns the import path of the package in the dir.
+func importPath(dir string) (string, error) {
+	out, err := exec.Command("go", "list", "-find", "-f", "{{.ImportPath}}", dir).Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve import path of %s: %w", dir, err)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+// SnakeCase converts the method name to the operation name, e.g. CreateKits
+// to create_kits.
+func SnakeCase(s string) string {
+	var b strings.Builder
+	rs := []rune(s)
+	for i, r := range rs {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && !unicode.IsUpper(rs[i-1])
+			nextLower := i > 0 && i+1 < len(rs) && unicode.IsLower(rs[i+1]) && unicode.IsUpper(rs[i-1])
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
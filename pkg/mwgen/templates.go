@@ -0,0 +1,159 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package mwgen
+
+import "text/template"
+
+const header = `// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by mwgen. DO NOT EDIT.
+
+{{if .BuildTags}}// +build {{.BuildTags}}
+
+{{end}}package {{.Package}}
+`
+
+var loggingTpl = template.Must(template.New("logging").Parse(header + `
+{{.Imports "context" "errors" "fmt" "time" "log github.com/mainflux/mainflux/logger"}}
+
+var _ {{.Service}} = (*loggingMiddleware)(nil)
+
+type loggingMiddleware struct {
+	logger log.Logger
+	svc    {{.Service}}
+}
+
+// LoggingMiddleware adds logging facilities to the core service.
+func LoggingMiddleware(svc {{.Service}}, logger log.Logger) {{.Service}} {
+	return &loggingMiddleware{logger, svc}
+}
+{{range .Methods}}
+func (lm *loggingMiddleware) {{.Name}}({{.Params}}) {{if .Named}}({{.Named}}) {{end}}{
+	{{- range .Track}}
+	{{.Ctx}}, {{.Var}} := {{.Func}}({{.Ctx}}){{end}}
+	defer func(begin time.Time) {
+		message := fmt.Sprintf("{{.Format}}", {{.Args}}time.Since(begin))
+		lm.log(message, {{.Err}})
+	}(time.Now())
+
+	{{if .Results}}return {{end}}lm.svc.{{.Name}}({{.Call}})
+}
+{{end}}
+// log logs the outcome of the method, using the warning level for the
+// errors expected to be returned by the service and the error level for
+// all the others.
+func (lm *loggingMiddleware) log(message string, err error) {
+	switch {
+	case err == nil:
+		lm.logger.Info(fmt.Sprintf("%s without errors.", message))
+	case expectedError(err):
+		lm.logger.Warn(fmt.Sprintf("%s with error: %s.", message, err))
+	default:
+		lm.logger.Error(fmt.Sprintf("%s with error: %s.", message, err))
+	}
+}
+
+func expectedError(err error) bool {
+	expected := []error{
+		context.Canceled,
+		context.DeadlineExceeded,{{range .Errors}}
+		{{$.PkgName}}.{{.}},{{end}}
+	}
+	for _, e := range expected {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+
+	return false
+}
+{{range .Failed}}
+func {{.Func}}(res {{.Type}}) int {
+	n := 0
+	for _, r := range res {
+		if r.{{.Field}} != nil {
+			n++
+		}
+	}
+
+	return n
+}
+{{end}}`))
+
+var metricsTpl = template.Must(template.New("metrics").Parse(header + `
+{{.Imports "time" "github.com/go-kit/kit/metrics"}}
+
+var _ {{.Service}} = (*metricsMiddleware)(nil)
+
+type metricsMiddleware struct {
+	counter metrics.Counter
+	latency metrics.Histogram
+	items   metrics.Counter
+	svc     {{.Service}}
+}
+
+// MetricsMiddleware instruments core service by tracking request count and
+// latency, as well as the number of items processed by the requests.{{if .Labels}}
+// Requests are counted by method and {{.Labels}}.{{end}}
+func MetricsMiddleware(svc {{.Service}}, counter metrics.Counter, latency metrics.Histogram, items metrics.Counter) {{.Service}} {
+	return &metricsMiddleware{
+		counter: counter,
+		latency: latency,
+		items:   items,
+		svc:     svc,
+	}
+}
+{{range .Methods}}
+func (ms *metricsMiddleware) {{.Name}}({{.Params}}) {{.Results}} {
+	{{- range .Track}}
+	{{.Ctx}}, {{.Var}} := {{.Func}}({{.Ctx}}){{end}}
+	defer func(begin time.Time) {
+		ms.counter.With("method", "{{.Op}}"{{.LabelValues}}).Add(1)
+		ms.latency.With("method", "{{.Op}}").Observe(time.Since(begin).Seconds()){{if .Items}}
+		ms.items.With("method", "{{.Op}}").Add(float64(len({{.Items}}))){{end}}
+	}(time.Now())
+
+	{{if .Results}}return {{end}}ms.svc.{{.Name}}({{.Call}})
+}
+{{end}}`))
+
+var tracingTpl = template.Must(template.New("tracing").Parse(header + `
+{{.Imports "opentracing github.com/opentracing/opentracing-go" "github.com/opentracing/opentracing-go/ext" "otlog github.com/opentracing/opentracing-go/log"}}
+
+var _ {{.Service}} = (*tracingMiddleware)(nil)
+
+type tracingMiddleware struct {
+	tracer opentracing.Tracer
+	svc    {{.Service}}
+}
+
+// TracingMiddleware traces the core service methods using spans that are
+// children of the spans carried by the request context.
+func TracingMiddleware(svc {{.Service}}, tracer opentracing.Tracer) {{.Service}} {
+	return &tracingMiddleware{tracer, svc}
+}
+{{range .Methods}}
+func (tm *tracingMiddleware) {{.Name}}({{.Params}}) {{if .Named}}({{.Named}}) {{end}}{
+	{{if .Ctx}}span, {{.Ctx}} := opentracing.StartSpanFromContextWithTracer({{.Ctx}}, tm.tracer, "{{.Op}}"){{else}}span := tm.tracer.StartSpan("{{.Op}}"){{end}}{{range .Tags}}
+	span.SetTag("{{.Key}}", {{.Value}}){{end}}
+	{{- range .Track}}
+	{{.Ctx}}, {{.Var}} := {{.Func}}({{.Ctx}}){{end}}
+	defer func() {
+		{{- range .Track}}
+		span.SetTag("{{.Name}}", {{.Var}}()){{end}}
+		finishSpan(span, {{.Err}})
+	}()
+
+	{{if .Results}}return {{end}}tm.svc.{{.Name}}({{.Call}})
+}
+{{end}}
+func finishSpan(span opentracing.Span, err error) {
+	if err != nil {
+		ext.Error.Set(span, true)
+		span.LogFields(otlog.Error(err))
+	}
+	span.Finish()
+}
+`))
@@ -0,0 +1,22 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package things declares the service used by the generator tests.
+package things
+
+import "context"
+
+type Thing struct {
+	ID    string
+	Owner string
+	Name  string
+}
+
+type Service interface {
+	Update(ctx context.Context, token string, thing Thing) error
+	Purge(ctx context.Context) (uint64, error)
+}
+
+func TrackKeyID(ctx context.Context) (context.Context, func() string) {
+	return ctx, func() string { return "" }
+}
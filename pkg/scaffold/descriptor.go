@@ -0,0 +1,136 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+var (
+	errMissingDescriptor = errors.New("missing file descriptor")
+
+	descriptorRegExp = regexp.MustCompile(`(?s)var (fileDescriptor_[0-9a-f]+) = \[\]byte\{\n(.*?)\n\}\n`)
+	byteRegExp       = regexp.MustCompile(`0x([0-9a-f]{2})`)
+)
+
+// renameDescriptor renames the file descriptor embedded in the generated
+// protobuf code, which is gzipped and can not be renamed as the rest of the
+// source. Its variable name is derived from the proto file name, the same
+// way protoc-gen-gofast does.
+func renameDescriptor(src []byte, r *strings.Replacer) ([]byte, error) {
+	m := descriptorRegExp.FindSubmatchIndex(src)
+	if m == nil {
+		return nil, errMissingDescriptor
+	}
+	name := string(src[m[2]:m[3]])
+
+	fd, err := decodeDescriptor(src[m[4]:m[5]])
+	if err != nil {
+		return nil, err
+	}
+	renameStrings(fd.ProtoReflect(), r)
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw, _ := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	h := sha256.Sum256([]byte(fd.GetName()))
+	newName := fmt.Sprintf("fileDescriptor_%s", hex.EncodeToString(h[:8]))
+
+	var out bytes.Buffer
+	out.Write(src[:m[0]])
+	fmt.Fprintf(&out, "var %s = []byte{\n", newName)
+	fmt.Fprintf(&out, "\t// %d bytes of a gzipped FileDescriptorProto\n", buf.Len())
+	for b := buf.Bytes(); len(b) > 0; {
+		n := 16
+		if n > len(b) {
+			n = len(b)
+		}
+		vals := make([]string, n)
+		for i, c := range b[:n] {
+			vals[i] = fmt.Sprintf("0x%02x,", c)
+		}
+		fmt.Fprintf(&out, "\t%s\n", strings.Join(vals, " "))
+		b = b[n:]
+	}
+	out.WriteString("}\n")
+	out.Write(src[m[1]:])
+
+	return bytes.ReplaceAll(out.Bytes(), []byte(name), []byte(newName)), nil
+}
+
+// decodeDescriptor decodes the gzipped file descriptor from the source of
+// its byte slice elements.
+func decodeDescriptor(elems []byte) (*descriptorpb.FileDescriptorProto, error) {
+	var gz []byte
+	for _, b := range byteRegExp.FindAllSubmatch(elems, -1) {
+		v, err := strconv.ParseUint(string(b[1]), 16, 8)
+		if err != nil {
+			return nil, err
+		}
+		gz = append(gz, byte(v))
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	fd := &descriptorpb.FileDescriptorProto{}
+	if err := proto.Unmarshal(data, fd); err != nil {
+		return nil, err
+	}
+
+	return fd, nil
+}
+
+// renameStrings replaces the names in all the string fields of the message.
+func renameStrings(m protoreflect.Message, r *strings.Replacer) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList() && fd.Kind() == protoreflect.StringKind:
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				l.Set(i, protoreflect.ValueOfString(r.Replace(l.Get(i).String())))
+			}
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind:
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				renameStrings(l.Get(i).Message(), r)
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString(r.Replace(v.String())))
+		case fd.Kind() == protoreflect.MessageKind:
+			renameStrings(v.Message(), r)
+		}
+		return true
+	})
+}
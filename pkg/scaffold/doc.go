@@ -0,0 +1,9 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scaffold contains the generator that creates a new Mainflux
+// service from the mfxkit template. The service package, its API and
+// middlewares, the command and the Docker files are copied with the
+// module path, names, environment variable prefix, metrics namespace
+// and ports substituted consistently.
+package scaffold
@@ -0,0 +1,218 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"errors"
+	"fmt"
+	"go/format"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/mainflux/mfxkit"
+)
+
+const (
+	tplName     = "mfxkit"
+	tplModule   = "github.com/mainflux/mfxkit"
+	tplHTTPPort = "9021"
+	tplGRPCPort = "9020"
+
+	// tplTestServer depends on the mfxkit SDK, which is not generated, so
+	// neither the package nor the tests importing it are.
+	tplTestServer = tplName + "/" + tplName + "test"
+
+	// DefModule is the module path of the Mainflux repository.
+	DefModule = "github.com/mainflux/mainflux"
+)
+
+var (
+	// ErrInvalidName indicates that the service name is not a lowercase
+	// alphanumeric word starting with a letter.
+	ErrInvalidName = errors.New("invalid service name")
+
+	// ErrInvalidModule indicates that the module path is empty or malformed.
+	ErrInvalidModule = errors.New("invalid module path")
+
+	// ErrInvalidPort indicates that the port is not a valid port number.
+	ErrInvalidPort = errors.New("invalid port")
+
+	// ErrFileExists indicates that a generated file would overwrite an
+	// existing one.
+	ErrFileExists = errors.New("file already exists")
+
+	nameRegExp   = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+	moduleRegExp = regexp.MustCompile(`^[a-zA-Z0-9._~\-]+(/[a-zA-Z0-9._~\-]+)*$`)
+)
+
+// Config contains the parameters of the generated service.
+type Config struct {
+	// Name of the service, used as its package name, e.g. "readers".
+	Name string
+
+	// Module is the module path of the repository the service is
+	// generated in.
+	Module string
+
+	// HTTPPort is the default HTTP port of the service.
+	HTTPPort string
+
+	// GRPCPort is the default gRPC port of the service.
+	GRPCPort string
+}
+
+func (cfg Config) validate() error {
+	if !nameRegExp.MatchString(cfg.Name) {
+		return ErrInvalidName
+	}
+
+	if !moduleRegExp.MatchString(cfg.Module) {
+		return ErrInvalidModule
+	}
+
+	for _, p := range []string{cfg.HTTPPort, cfg.GRPCPort} {
+		n, err := strconv.ParseUint(p, 10, 16)
+		if err != nil || n == 0 {
+			return ErrInvalidPort
+		}
+	}
+
+	return nil
+}
+
+// Generate writes the sources of the new service to the dir, laid out as
+// in the target repository: the service package in <name>, the command in
+// cmd/<name> and the Docker files in docker/<name>. No file is written if
+// any of them already exists. Paths of the generated files are returned.
+func Generate(dir string, cfg Config) ([]string, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	files, err := render(mfxkit.Template, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		p := filepath.Join(dir, filepath.FromSlash(f.path))
+		if _, err := os.Stat(p); err == nil {
+			return nil, fmt.Errorf("%w: %s", ErrFileExists, p)
+		}
+		paths = append(paths, p)
+	}
+
+	for i, f := range files {
+		if err := os.MkdirAll(filepath.Dir(paths[i]), 0755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(paths[i], f.data, 0644); err != nil {
+			return nil, err
+		}
+	}
+
+	return paths, nil
+}
+
+type file struct {
+	path string
+	data []byte
+}
+
+// render returns the renamed template files.
+func render(tpl fs.FS, cfg Config) ([]file, error) {
+	r := strings.NewReplacer(
+		tplModule+"/cmd/mwgen", tplModule+"/cmd/mwgen",
+		tplModule+"/cmd/httpgen", tplModule+"/cmd/httpgen",
+		tplModule+"/pkg/mwgen", tplModule+"/pkg/mwgen",
+		tplModule+"/pkg/httpgen", tplModule+"/pkg/httpgen",
+		tplModule+"/"+tplName, path.Join(cfg.Module, cfg.Name),
+		tplModule, cfg.Module,
+		strings.ToUpper(tplName), strings.ToUpper(cfg.Name),
+		title(tplName), title(cfg.Name),
+		tplName, cfg.Name,
+		tplHTTPPort, cfg.HTTPPort,
+		tplGRPCPort, cfg.GRPCPort,
+	)
+
+	var files []file
+	err := fs.WalkDir(tpl, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if p == tplTestServer {
+				return fs.SkipDir
+			}
+			return nil
+		}
+
+		data, err := fs.ReadFile(tpl, p)
+		if err != nil {
+			return err
+		}
+		// Tests using the test server are skipped along with it, and so are
+		// the docs referring to it.
+		if strings.HasSuffix(p, "_test.go") && strings.Contains(string(data), tplModule+"/"+tplTestServer+`"`) {
+			return nil
+		}
+		if path.Ext(p) == ".md" {
+			data = dropParagraphs(data, path.Base(tplTestServer)+".")
+		}
+
+		data = []byte(r.Replace(string(data)))
+		if strings.HasSuffix(p, ".pb.go") {
+			if data, err = renameDescriptor(data, r); err != nil {
+				return fmt.Errorf("%s: %w", p, err)
+			}
+		}
+		if strings.HasSuffix(p, ".go") {
+			if data, err = format.Source(data); err != nil {
+				return fmt.Errorf("%s: %w", p, err)
+			}
+		}
+
+		files = append(files, file{
+			path: targetPath(p, cfg.Name, r),
+			data: data,
+		})
+		return nil
+	})
+
+	return files, err
+}
+
+// dropParagraphs removes the Markdown paragraphs containing the given text.
+func dropParagraphs(data []byte, text string) []byte {
+	paras := strings.Split(string(data), "\n\n")
+	kept := paras[:0]
+	for _, p := range paras {
+		if !strings.Contains(p, text) {
+			kept = append(kept, p)
+		}
+	}
+
+	return []byte(strings.Join(kept, "\n\n"))
+}
+
+func title(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// targetPath returns the path of the generated file. The Docker files are
+// moved to their own directory, since the target repository contains
+// Docker files of other services too.
+func targetPath(p, name string, r *strings.Replacer) string {
+	if path.Dir(p) == "docker" {
+		return path.Join("docker", name, path.Base(p))
+	}
+
+	return r.Replace(p)
+}
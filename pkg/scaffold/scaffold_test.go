@@ -0,0 +1,72 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package scaffold
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+// generatorRegExp matches the generator module paths, which are kept.
+var generatorRegExp = regexp.MustCompile(tplModule + `/(cmd|pkg)/(mwgen|httpgen)`)
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Config{
+		Name:     "readers",
+		Module:   "example.com/acme",
+		HTTPPort: "9031",
+		GRPCPort: "9030",
+	}
+
+	paths, err := Generate(dir, cfg)
+	require.Nil(t, err, fmt.Sprintf("generate: unexpected error: %s", err))
+	require.NotEmpty(t, paths, "generate: expected generated files")
+
+	main, err := os.ReadFile(filepath.Join(dir, "cmd", "readers", "main.go"))
+	require.Nil(t, err, fmt.Sprintf("read command: unexpected error: %s", err))
+	assert.Contains(t, string(main), `"example.com/acme/readers"`, "command: expected the renamed module path")
+
+	src, err := os.ReadFile(filepath.Join(dir, "readers", "api", "readers", "grpc", "readers.pb.go"))
+	require.Nil(t, err, fmt.Sprintf("read descriptor: unexpected error: %s", err))
+	m := descriptorRegExp.FindSubmatch(src)
+	require.NotNil(t, m, "descriptor: expected the file descriptor")
+	fd, err := decodeDescriptor(m[2])
+	require.Nil(t, err, fmt.Sprintf("decode descriptor: unexpected error: %s", err))
+	assert.Equal(t, "readers.proto", fd.GetName(), fmt.Sprintf("descriptor: expected name readers.proto got %s", fd.GetName()))
+	assert.Equal(t, "readers", fd.GetPackage(), fmt.Sprintf("descriptor: expected package readers got %s", fd.GetPackage()))
+	data, err := proto.Marshal(fd)
+	require.Nil(t, err, fmt.Sprintf("marshal descriptor: unexpected error: %s", err))
+	assert.NotContains(t, strings.ToLower(string(data)), tplName, "descriptor: expected no template identifiers")
+
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		require.Nil(t, err, fmt.Sprintf("read %s: unexpected error: %s", p, err))
+		rel, _ := filepath.Rel(dir, p)
+		assert.NotContains(t, strings.ToLower(rel), tplName, fmt.Sprintf("%s: expected no template identifiers in the path", rel))
+		data = generatorRegExp.ReplaceAll(data, nil)
+		assert.NotContains(t, strings.ToLower(string(data)), tplName, fmt.Sprintf("%s: expected no template identifiers", rel))
+		assert.NotContains(t, string(data), "readerstest", fmt.Sprintf("%s: expected no references to the skipped test server", rel))
+	}
+}
+
+func TestGenerateExisting(t *testing.T) {
+	dir := t.TempDir()
+	cfg := Config{Name: "readers", Module: DefModule, HTTPPort: "9031", GRPCPort: "9030"}
+
+	_, err := Generate(dir, cfg)
+	require.Nil(t, err, fmt.Sprintf("generate: unexpected error: %s", err))
+
+	_, err = Generate(dir, cfg)
+	assert.True(t, errors.Is(err, ErrFileExists), fmt.Sprintf("generate again: expected %s got %s", ErrFileExists, err))
+}
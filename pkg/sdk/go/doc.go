@@ -0,0 +1,5 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package sdk contains Go SDK for the mfxkit HTTP API.
+package sdk
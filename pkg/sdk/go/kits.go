@@ -0,0 +1,285 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const kitsEndpoint = "kits"
+
+// Kit represents mfxkit kit.
+type Kit struct {
+	ID        string                 `json:"id,omitempty"`
+	Name      string                 `json:"name,omitempty"`
+	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+	Revision  uint64                 `json:"revision,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// PageMetadata contains parameters of the kits page. Zero values are
+// replaced with the service defaults.
+type PageMetadata struct {
+	Offset   uint64
+	Limit    uint64
+	Order    string
+	Dir      string
+	Name     string
+	Metadata map[string]interface{}
+}
+
+// KitsPage contains a page of kits.
+type KitsPage struct {
+	Total  uint64 `json:"total"`
+	Offset uint64 `json:"offset"`
+	Limit  uint64 `json:"limit"`
+	Order  string `json:"order"`
+	Dir    string `json:"direction"`
+	Kits   []Kit  `json:"kits"`
+}
+
+// BulkResult contains the outcome of a single bulk operation item.
+type BulkResult struct {
+	ID     string `json:"id,omitempty"`
+	Status int    `json:"status"`
+	Error  string `json:"error,omitempty"`
+	Kit    *Kit   `json:"kit,omitempty"`
+}
+
+// Err returns the error matching the item status, or nil if the item
+// succeeded.
+func (br BulkResult) Err() error {
+	if br.Status < http.StatusBadRequest {
+		return nil
+	}
+
+	return decodeError(&http.Response{StatusCode: br.Status})
+}
+
+func (sdk mfxkitSDK) CreateKit(ctx context.Context, kit Kit, token string) (Kit, error) {
+	data, err := json.Marshal(kitReq{Name: kit.Name, Metadata: kit.Metadata})
+	if err != nil {
+		return Kit{}, err
+	}
+
+	resp, err := sdk.sendRequest(ctx, http.MethodPost, kitsEndpoint, bytes.NewReader(data), token, nil)
+	if err != nil {
+		return Kit{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return Kit{}, decodeError(resp)
+	}
+
+	var k Kit
+	if err := json.NewDecoder(resp.Body).Decode(&k); err != nil {
+		return Kit{}, err
+	}
+
+	return k, nil
+}
+
+func (sdk mfxkitSDK) CreateKits(ctx context.Context, kits []Kit, atomic bool, token string) ([]BulkResult, error) {
+	reqs := make([]kitReq, len(kits))
+	for i, kit := range kits {
+		reqs[i] = kitReq{Name: kit.Name, Metadata: kit.Metadata}
+	}
+
+	return sdk.bulk(ctx, http.MethodPost, reqs, atomic, token)
+}
+
+func (sdk mfxkitSDK) Kit(ctx context.Context, id, token string) (Kit, error) {
+	endpoint := fmt.Sprintf("%s/%s", kitsEndpoint, url.PathEscape(id))
+
+	resp, err := sdk.sendRequest(ctx, http.MethodGet, endpoint, nil, token, nil)
+	if err != nil {
+		return Kit{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Kit{}, decodeError(resp)
+	}
+
+	var k Kit
+	if err := json.NewDecoder(resp.Body).Decode(&k); err != nil {
+		return Kit{}, err
+	}
+
+	return k, nil
+}
+
+func (sdk mfxkitSDK) Kits(ctx context.Context, pm PageMetadata, token string) (KitsPage, error) {
+	query, err := pm.query()
+	if err != nil {
+		return KitsPage{}, err
+	}
+
+	endpoint := kitsEndpoint
+	if len(query) > 0 {
+		endpoint = fmt.Sprintf("%s?%s", kitsEndpoint, query.Encode())
+	}
+
+	resp, err := sdk.sendRequest(ctx, http.MethodGet, endpoint, nil, token, nil)
+	if err != nil {
+		return KitsPage{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return KitsPage{}, decodeError(resp)
+	}
+
+	var kp KitsPage
+	if err := json.NewDecoder(resp.Body).Decode(&kp); err != nil {
+		return KitsPage{}, err
+	}
+
+	return kp, nil
+}
+
+func (sdk mfxkitSDK) UpdateKit(ctx context.Context, kit Kit, token string) (Kit, error) {
+	data, err := json.Marshal(kitReq{Name: kit.Name, Metadata: kit.Metadata})
+	if err != nil {
+		return Kit{}, err
+	}
+
+	endpoint := fmt.Sprintf("%s/%s", kitsEndpoint, url.PathEscape(kit.ID))
+
+	resp, err := sdk.sendRequest(ctx, http.MethodPut, endpoint, bytes.NewReader(data), token, ifMatch(kit.Revision))
+	if err != nil {
+		return Kit{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Kit{}, decodeError(resp)
+	}
+
+	var k Kit
+	if err := json.NewDecoder(resp.Body).Decode(&k); err != nil {
+		return Kit{}, err
+	}
+
+	return k, nil
+}
+
+func (sdk mfxkitSDK) DeleteKit(ctx context.Context, id string, revision uint64, token string) error {
+	endpoint := fmt.Sprintf("%s/%s", kitsEndpoint, url.PathEscape(id))
+
+	resp, err := sdk.sendRequest(ctx, http.MethodDelete, endpoint, nil, token, ifMatch(revision))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return decodeError(resp)
+	}
+
+	return nil
+}
+
+func (sdk mfxkitSDK) DeleteKits(ctx context.Context, kits []Kit, atomic bool, token string) ([]BulkResult, error) {
+	reqs := make([]removeKitReq, len(kits))
+	for i, kit := range kits {
+		reqs[i] = removeKitReq{ID: kit.ID, Revision: kit.Revision}
+	}
+
+	return sdk.bulk(ctx, http.MethodDelete, reqs, atomic, token)
+}
+
+func (sdk mfxkitSDK) RestoreKit(ctx context.Context, id, token string) (Kit, error) {
+	endpoint := fmt.Sprintf("%s/%s/restore", kitsEndpoint, url.PathEscape(id))
+
+	resp, err := sdk.sendRequest(ctx, http.MethodPost, endpoint, nil, token, nil)
+	if err != nil {
+		return Kit{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Kit{}, decodeError(resp)
+	}
+
+	var k Kit
+	if err := json.NewDecoder(resp.Body).Decode(&k); err != nil {
+		return Kit{}, err
+	}
+
+	return k, nil
+}
+
+func (sdk mfxkitSDK) bulk(ctx context.Context, method string, items interface{}, atomic bool, token string) ([]BulkResult, error) {
+	data, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("%s/bulk?atomic=%t", kitsEndpoint, atomic)
+
+	resp, err := sdk.sendRequest(ctx, method, endpoint, bytes.NewReader(data), token, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMultiStatus {
+		return nil, decodeError(resp)
+	}
+
+	var br bulkRes
+	if err := json.NewDecoder(resp.Body).Decode(&br); err != nil {
+		return nil, err
+	}
+
+	return br.Results, nil
+}
+
+func (pm PageMetadata) query() (url.Values, error) {
+	q := url.Values{}
+	if pm.Offset != 0 {
+		q.Set("offset", strconv.FormatUint(pm.Offset, 10))
+	}
+	if pm.Limit != 0 {
+		q.Set("limit", strconv.FormatUint(pm.Limit, 10))
+	}
+	if pm.Order != "" {
+		q.Set("order", pm.Order)
+	}
+	if pm.Dir != "" {
+		q.Set("dir", pm.Dir)
+	}
+	if pm.Name != "" {
+		q.Set("name", pm.Name)
+	}
+	if len(pm.Metadata) > 0 {
+		data, err := json.Marshal(pm.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		q.Set("metadata", string(data))
+	}
+
+	return q, nil
+}
+
+// ifMatch returns the If-Match header for the given revision. Zero revision
+// skips the revision check.
+func ifMatch(revision uint64) map[string]string {
+	if revision == 0 {
+		return nil
+	}
+
+	return map[string]string{"If-Match": fmt.Sprintf(`"%d"`, revision)}
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const pingEndpoint = "mfxkit"
+
+func (sdk mfxkitSDK) Ping(ctx context.Context, secret string) (string, string, error) {
+	data, err := json.Marshal(pingReq{Secret: secret})
+	if err != nil {
+		return "", "", err
+	}
+
+	resp, err := sdk.sendRequest(ctx, http.MethodPost, pingEndpoint, bytes.NewReader(data), "", nil)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusForbidden:
+		// Ping rejects invalid secrets with 403, unlike the other routes.
+		return "", "", &StatusError{StatusCode: resp.StatusCode, Err: ErrUnauthorized}
+	default:
+		return "", "", decodeError(resp)
+	}
+
+	var pr pingRes
+	if err := json.NewDecoder(resp.Body).Decode(&pr); err != nil {
+		return "", "", err
+	}
+
+	return pr.Greeting, pr.KeyID, nil
+}
@@ -0,0 +1,18 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+type pingReq struct {
+	Secret string `json:"secret"`
+}
+
+type kitReq struct {
+	Name     string                 `json:"name,omitempty"`
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type removeKitReq struct {
+	ID       string `json:"id"`
+	Revision uint64 `json:"revision,omitempty"`
+}
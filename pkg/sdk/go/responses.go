@@ -0,0 +1,18 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+type pingRes struct {
+	Greeting string `json:"greeting"`
+	KeyID    string `json:"key_id"`
+}
+
+type bulkRes struct {
+	Results []BulkResult `json:"results"`
+}
+
+type versionRes struct {
+	Service string `json:"service"`
+	Version string `json:"version"`
+}
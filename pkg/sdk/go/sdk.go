@@ -0,0 +1,223 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// CTJSON represents JSON content type.
+	CTJSON = "application/json"
+
+	// statusClientClosedRequest is the non-standard status the service
+	// responds with to the requests cancelled by the client.
+	statusClientClosedRequest = 499
+)
+
+var (
+	// ErrInvalidRequest indicates that the request was rejected as malformed.
+	ErrInvalidRequest = errors.New("malformed request")
+
+	// ErrUnauthorized indicates missing or invalid credentials.
+	ErrUnauthorized = errors.New("missing or invalid credentials provided")
+
+	// ErrForbidden indicates that the identified caller is not allowed to
+	// access the resource, e.g. a thing not connected to the channel.
+	ErrForbidden = errors.New("access to the resource is forbidden")
+
+	// ErrNotFound indicates that the requested entity does not exist.
+	ErrNotFound = errors.New("non-existent entity")
+
+	// ErrConflict indicates that the entity has been modified since the
+	// revision the request is based on.
+	ErrConflict = errors.New("entity revision conflict")
+
+	// ErrUnsupportedContentType indicates that the request content type was
+	// not accepted.
+	ErrUnsupportedContentType = errors.New("unsupported content type")
+
+	// ErrBulkAborted indicates that the bulk operation item was not applied
+	// because another item of the same all-or-nothing operation failed.
+	ErrBulkAborted = errors.New("bulk operation aborted")
+
+	// ErrServer indicates that the service failed to handle the request.
+	ErrServer = errors.New("internal server error")
+
+	// ErrUnexpectedStatus indicates a response status not expected by SDK.
+	ErrUnexpectedStatus = errors.New("unexpected response status")
+)
+
+var _ SDK = (*mfxkitSDK)(nil)
+
+// StatusError is returned when the service responds with an error status.
+// It wraps one of the SDK errors, so it can be inspected using errors.Is.
+type StatusError struct {
+	StatusCode int
+	Err        error
+}
+
+func (se *StatusError) Error() string {
+	return fmt.Sprintf("%s (%d %s)", se.Err, se.StatusCode, http.StatusText(se.StatusCode))
+}
+
+// Unwrap returns the SDK error matching the status code.
+func (se *StatusError) Unwrap() error {
+	return se.Err
+}
+
+// SDK contains mfxkit HTTP API. Every call is bound to the given context
+// and can be cancelled using it.
+type SDK interface {
+	// Ping compares the given secret with the service secrets and returns
+	// the service greeting and the ID of the matching secret.
+	Ping(ctx context.Context, secret string) (greeting, keyID string, err error)
+
+	// CreateKit creates a new kit and returns it.
+	CreateKit(ctx context.Context, kit Kit, token string) (Kit, error)
+
+	// CreateKits creates new kits and reports the outcome of each of them.
+	// If atomic is set, either all kits are created or none is.
+	CreateKits(ctx context.Context, kits []Kit, atomic bool, token string) ([]BulkResult, error)
+
+	// Kit returns the kit identified by the given ID.
+	Kit(ctx context.Context, id, token string) (Kit, error)
+
+	// Kits returns the page of kits matching the given page metadata.
+	Kits(ctx context.Context, pm PageMetadata, token string) (KitsPage, error)
+
+	// UpdateKit updates the name and metadata of the existing kit, and
+	// returns the updated kit. If the kit revision is set, it must match
+	// the current one.
+	UpdateKit(ctx context.Context, kit Kit, token string) (Kit, error)
+
+	// DeleteKit removes the kit identified by the given ID. If the revision
+	// is set, it must match the current one.
+	DeleteKit(ctx context.Context, id string, revision uint64, token string) error
+
+	// DeleteKits removes the kits identified by the IDs of the given kits and
+	// reports the outcome of each of them. If atomic is set, either all kits
+	// are removed or none is.
+	DeleteKits(ctx context.Context, kits []Kit, atomic bool, token string) ([]BulkResult, error)
+
+	// RestoreKit restores the removed kit identified by the given ID.
+	RestoreKit(ctx context.Context, id, token string) (Kit, error)
+
+	// Version returns the service version.
+	Version(ctx context.Context) (string, error)
+}
+
+type mfxkitSDK struct {
+	baseURL   string
+	channelID string
+	client    *http.Client
+}
+
+// Config contains sdk configuration parameters.
+type Config struct {
+	// BaseURL is the URL of the service HTTP API, e.g. http://localhost:9021.
+	BaseURL string
+
+	// Timeout limits the duration of each request. Zero means no timeout.
+	Timeout time.Duration
+
+	// TLSInsecure disables verification of the server certificate, e.g.
+	// for a development server using a self-signed certificate. The server
+	// certificate is verified by default.
+	TLSInsecure bool
+
+	// TLSConfig, if set, is used instead of the configuration derived from
+	// TLSInsecure, e.g. to provide CA or client certificates.
+	TLSConfig *tls.Config
+
+	// ChannelID, if set, is sent with every request, so that the service
+	// authorizes the thing whose key is used as the token to access the
+	// channel.
+	ChannelID string
+}
+
+// NewSDK returns new mfxkit SDK instance.
+func NewSDK(conf Config) SDK {
+	tlsConfig := conf.TLSConfig
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{
+			InsecureSkipVerify: conf.TLSInsecure,
+		}
+	}
+
+	return &mfxkitSDK{
+		baseURL:   conf.BaseURL,
+		channelID: conf.ChannelID,
+		client: &http.Client{
+			Timeout: conf.Timeout,
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+	}
+}
+
+func (sdk mfxkitSDK) sendRequest(ctx context.Context, method, endpoint string, body io.Reader, token string, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", sdk.baseURL, endpoint), body)
+	if err != nil {
+		return nil, err
+	}
+
+	if token != "" {
+		req.Header.Set("Authorization", token)
+	}
+
+	if sdk.channelID != "" {
+		req.Header.Set("Channel-ID", sdk.channelID)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", CTJSON)
+	}
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	return sdk.client.Do(req)
+}
+
+// decodeError returns the error matching the response status code.
+func decodeError(resp *http.Response) error {
+	se := &StatusError{StatusCode: resp.StatusCode}
+
+	switch {
+	case resp.StatusCode == http.StatusBadRequest:
+		se.Err = ErrInvalidRequest
+	case resp.StatusCode == http.StatusUnauthorized:
+		se.Err = ErrUnauthorized
+	case resp.StatusCode == http.StatusForbidden:
+		se.Err = ErrForbidden
+	case resp.StatusCode == http.StatusNotFound:
+		se.Err = ErrNotFound
+	case resp.StatusCode == http.StatusConflict:
+		se.Err = ErrConflict
+	case resp.StatusCode == http.StatusUnsupportedMediaType:
+		se.Err = ErrUnsupportedContentType
+	case resp.StatusCode == http.StatusFailedDependency:
+		se.Err = ErrBulkAborted
+	case resp.StatusCode == statusClientClosedRequest:
+		se.Err = context.Canceled
+	case resp.StatusCode == http.StatusGatewayTimeout:
+		se.Err = context.DeadlineExceeded
+	case resp.StatusCode >= http.StatusInternalServerError:
+		se.Err = ErrServer
+	default:
+		se.Err = ErrUnexpectedStatus
+	}
+
+	return se
+}
@@ -0,0 +1,316 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/mainflux/mfxkit/pkg/sdk/go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	token  = "token"
+	chanID = "channel"
+)
+
+// request is the request received by the test server.
+type request struct {
+	method  string
+	uri     string
+	headers map[string]string
+	body    string
+}
+
+// recorder records the last received request and responds with the given
+// status and body.
+type recorder struct {
+	status int
+	body   string
+	req    request
+}
+
+func (rec *recorder) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	rec.req = request{
+		method: r.Method,
+		uri:    r.URL.RequestURI(),
+		headers: map[string]string{
+			"Authorization": r.Header.Get("Authorization"),
+			"Content-Type":  r.Header.Get("Content-Type"),
+			"If-Match":      r.Header.Get("If-Match"),
+			"Channel-ID":    r.Header.Get("Channel-ID"),
+		},
+		body: string(body),
+	}
+
+	w.Header().Set("Content-Type", sdk.CTJSON)
+	w.WriteHeader(rec.status)
+	fmt.Fprint(w, rec.body)
+}
+
+func TestRoutes(t *testing.T) {
+	rec := &recorder{}
+	ts := httptest.NewServer(rec)
+	defer ts.Close()
+
+	s := sdk.NewSDK(sdk.Config{BaseURL: ts.URL, ChannelID: chanID})
+	ctx := context.Background()
+	created := time.Date(2021, time.March, 1, 12, 0, 0, 0, time.UTC)
+	kit := sdk.Kit{ID: "id", Name: "kit", Metadata: map[string]interface{}{"key": "value"}, Revision: 2, CreatedAt: created, UpdatedAt: created}
+	kitJSON := `{"id":"id","name":"kit","metadata":{"key":"value"},"revision":2,"created_at":"2021-03-01T12:00:00Z","updated_at":"2021-03-01T12:00:00Z"}`
+	bulkJSON := `{"results":[{"id":"id","status":201,"kit":` + kitJSON + `},{"status":400,"error":"malformed entity specification"}]}`
+	bulk := []sdk.BulkResult{{ID: "id", Status: http.StatusCreated, Kit: &kit}, {Status: http.StatusBadRequest, Error: "malformed entity specification"}}
+
+	cases := []struct {
+		desc    string
+		call    func() (interface{}, error)
+		status  int
+		resBody string
+		req     request
+		res     interface{}
+	}{
+		{
+			desc: "ping",
+			call: func() (interface{}, error) {
+				greeting, keyID, err := s.Ping(ctx, "secret")
+				return []string{greeting, keyID}, err
+			},
+			status:  http.StatusOK,
+			resBody: `{"greeting":"Hello World :)","key_id":"default"}`,
+			req:     request{method: http.MethodPost, uri: "/mfxkit", body: `{"secret":"secret"}`},
+			res:     []string{"Hello World :)", "default"},
+		},
+		{
+			desc: "create kit",
+			call: func() (interface{}, error) {
+				return s.CreateKit(ctx, sdk.Kit{Name: "kit", Metadata: kit.Metadata}, token)
+			},
+			status:  http.StatusCreated,
+			resBody: kitJSON,
+			req:     request{method: http.MethodPost, uri: "/kits", body: `{"name":"kit","metadata":{"key":"value"}}`},
+			res:     kit,
+		},
+		{
+			desc:    "create kits",
+			call:    func() (interface{}, error) { return s.CreateKits(ctx, []sdk.Kit{{Name: "kit"}, {}}, true, token) },
+			status:  http.StatusMultiStatus,
+			resBody: bulkJSON,
+			req:     request{method: http.MethodPost, uri: "/kits/bulk?atomic=true", body: `[{"name":"kit"},{}]`},
+			res:     bulk,
+		},
+		{
+			desc:    "view kit",
+			call:    func() (interface{}, error) { return s.Kit(ctx, "id", token) },
+			status:  http.StatusOK,
+			resBody: kitJSON,
+			req:     request{method: http.MethodGet, uri: "/kits/id"},
+			res:     kit,
+		},
+		{
+			desc: "list kits",
+			call: func() (interface{}, error) {
+				pm := sdk.PageMetadata{Offset: 1, Limit: 5, Order: "name", Dir: "desc", Name: "kit", Metadata: map[string]interface{}{"key": "value"}}
+				return s.Kits(ctx, pm, token)
+			},
+			status:  http.StatusOK,
+			resBody: `{"total":1,"offset":1,"limit":5,"order":"name","direction":"desc","kits":[` + kitJSON + `]}`,
+			req:     request{method: http.MethodGet, uri: "/kits?dir=desc&limit=5&metadata=%7B%22key%22%3A%22value%22%7D&name=kit&offset=1&order=name"},
+			res:     sdk.KitsPage{Total: 1, Offset: 1, Limit: 5, Order: "name", Dir: "desc", Kits: []sdk.Kit{kit}},
+		},
+		{
+			desc: "update kit",
+			call: func() (interface{}, error) {
+				return s.UpdateKit(ctx, sdk.Kit{ID: "id", Name: "kit", Revision: 1}, token)
+			},
+			status:  http.StatusOK,
+			resBody: kitJSON,
+			req:     request{method: http.MethodPut, uri: "/kits/id", headers: map[string]string{"If-Match": `"1"`}, body: `{"name":"kit"}`},
+			res:     kit,
+		},
+		{
+			desc:   "delete kit",
+			call:   func() (interface{}, error) { return nil, s.DeleteKit(ctx, "id", 2, token) },
+			status: http.StatusNoContent,
+			req:    request{method: http.MethodDelete, uri: "/kits/id", headers: map[string]string{"If-Match": `"2"`}},
+		},
+		{
+			desc: "delete kits",
+			call: func() (interface{}, error) {
+				return s.DeleteKits(ctx, []sdk.Kit{{ID: "id", Revision: 2}, {ID: "other"}}, false, token)
+			},
+			status:  http.StatusMultiStatus,
+			resBody: `{"results":[{"id":"id","status":204},{"id":"other","status":404,"error":"non-existent entity"}]}`,
+			req:     request{method: http.MethodDelete, uri: "/kits/bulk?atomic=false", body: `[{"id":"id","revision":2},{"id":"other"}]`},
+			res:     []sdk.BulkResult{{ID: "id", Status: http.StatusNoContent}, {ID: "other", Status: http.StatusNotFound, Error: "non-existent entity"}},
+		},
+		{
+			desc:    "restore kit",
+			call:    func() (interface{}, error) { return s.RestoreKit(ctx, "id", token) },
+			status:  http.StatusOK,
+			resBody: kitJSON,
+			req:     request{method: http.MethodPost, uri: "/kits/id/restore"},
+			res:     kit,
+		},
+		{
+			desc:    "version",
+			call:    func() (interface{}, error) { return s.Version(ctx) },
+			status:  http.StatusOK,
+			resBody: `{"service":"mfxkit","version":"0.12.0"}`,
+			req:     request{method: http.MethodGet, uri: "/version"},
+			res:     "0.12.0",
+		},
+	}
+
+	for _, tc := range cases {
+		rec.status = tc.status
+		rec.body = tc.resBody
+
+		res, err := tc.call()
+		require.Nil(t, err, fmt.Sprintf("%s: unexpected error: %s", tc.desc, err))
+		if tc.res != nil {
+			assert.Equal(t, tc.res, res, fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.res, res))
+		}
+
+		headers := map[string]string{
+			"Authorization": "",
+			"Content-Type":  "",
+			"If-Match":      "",
+			"Channel-ID":    chanID,
+		}
+		if tc.req.uri != "/mfxkit" && tc.req.uri != "/version" {
+			headers["Authorization"] = token
+		}
+		if tc.req.body != "" {
+			headers["Content-Type"] = sdk.CTJSON
+		}
+		for k, v := range tc.req.headers {
+			headers[k] = v
+		}
+		tc.req.headers = headers
+		assert.Equal(t, tc.req, rec.req, fmt.Sprintf("%s: expected request %v got %v", tc.desc, tc.req, rec.req))
+	}
+}
+
+func TestErrors(t *testing.T) {
+	rec := &recorder{}
+	ts := httptest.NewServer(rec)
+	defer ts.Close()
+
+	s := sdk.NewSDK(sdk.Config{BaseURL: ts.URL})
+
+	cases := []struct {
+		desc   string
+		status int
+		err    error
+	}{
+		{"view kit with bad request", http.StatusBadRequest, sdk.ErrInvalidRequest},
+		{"view kit with unauthorized access", http.StatusUnauthorized, sdk.ErrUnauthorized},
+		{"view kit with forbidden access", http.StatusForbidden, sdk.ErrForbidden},
+		{"view kit with not found", http.StatusNotFound, sdk.ErrNotFound},
+		{"view kit with conflict", http.StatusConflict, sdk.ErrConflict},
+		{"view kit with unsupported media type", http.StatusUnsupportedMediaType, sdk.ErrUnsupportedContentType},
+		{"view kit with failed dependency", http.StatusFailedDependency, sdk.ErrBulkAborted},
+		{"view kit with client closed request", 499, context.Canceled},
+		{"view kit with internal server error", http.StatusInternalServerError, sdk.ErrServer},
+		{"view kit with gateway timeout", http.StatusGatewayTimeout, context.DeadlineExceeded},
+		{"view kit with unexpected status", http.StatusTeapot, sdk.ErrUnexpectedStatus},
+	}
+
+	for _, tc := range cases {
+		rec.status = tc.status
+
+		_, err := s.Kit(context.Background(), "id", token)
+		assert.True(t, errors.Is(err, tc.err), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+
+		var se *sdk.StatusError
+		require.True(t, errors.As(err, &se), fmt.Sprintf("%s: expected status error got %v", tc.desc, err))
+		assert.Equal(t, tc.status, se.StatusCode, fmt.Sprintf("%s: expected status code %d got %d", tc.desc, tc.status, se.StatusCode))
+	}
+
+	rec.status = http.StatusForbidden
+	_, _, err := s.Ping(context.Background(), "invalid")
+	assert.True(t, errors.Is(err, sdk.ErrUnauthorized), fmt.Sprintf("ping with invalid secret: expected %v got %v", sdk.ErrUnauthorized, err))
+
+	res := sdk.BulkResult{Status: http.StatusConflict}
+	assert.True(t, errors.Is(res.Err(), sdk.ErrConflict), fmt.Sprintf("bulk item with conflict: expected %v got %v", sdk.ErrConflict, res.Err()))
+	res = sdk.BulkResult{Status: http.StatusCreated}
+	assert.Nil(t, res.Err(), fmt.Sprintf("created bulk item: unexpected error: %v", res.Err()))
+}
+
+func TestContextCancellation(t *testing.T) {
+	done := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-done:
+		}
+	}))
+	defer ts.Close()
+	defer close(done)
+
+	cases := []struct {
+		desc    string
+		timeout time.Duration
+		ctx     func() (context.Context, context.CancelFunc)
+		err     error
+	}{
+		{
+			desc: "view kit with cancelled context",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(10*time.Millisecond, cancel)
+				return ctx, cancel
+			},
+			err: context.Canceled,
+		},
+		{
+			desc: "view kit with context deadline",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), 10*time.Millisecond)
+			},
+			err: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tc := range cases {
+		s := sdk.NewSDK(sdk.Config{BaseURL: ts.URL})
+		ctx, cancel := tc.ctx()
+
+		_, err := s.Kit(ctx, "id", token)
+		cancel()
+		assert.True(t, errors.Is(err, tc.err), fmt.Sprintf("%s: expected %v got %v", tc.desc, tc.err, err))
+	}
+
+	s := sdk.NewSDK(sdk.Config{BaseURL: ts.URL, Timeout: 10 * time.Millisecond})
+	_, err := s.Kit(context.Background(), "id", token)
+	assert.NotNil(t, err, "view kit with client timeout: expected error")
+}
+
+func TestTLSVerification(t *testing.T) {
+	ts := httptest.NewTLSServer(&recorder{status: http.StatusOK, body: `{"version":"0.12.0"}`})
+	defer ts.Close()
+
+	cases := []struct {
+		desc     string
+		conf     sdk.Config
+		verified bool
+	}{
+		{"request server with default config", sdk.Config{BaseURL: ts.URL}, false},
+		{"request server with insecure config", sdk.Config{BaseURL: ts.URL, TLSInsecure: true}, true},
+	}
+
+	for _, tc := range cases {
+		_, err := sdk.NewSDK(tc.conf).Version(context.Background())
+		assert.Equal(t, tc.verified, err == nil, fmt.Sprintf("%s: expected success %t got error %v", tc.desc, tc.verified, err))
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+package sdk
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+const versionEndpoint = "version"
+
+func (sdk mfxkitSDK) Version(ctx context.Context) (string, error) {
+	resp, err := sdk.sendRequest(ctx, http.MethodGet, versionEndpoint, nil, "", nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", decodeError(resp)
+	}
+
+	var vr versionRes
+	if err := json.NewDecoder(resp.Body).Decode(&vr); err != nil {
+		return "", err
+	}
+
+	return vr.Version, nil
+}
@@ -0,0 +1,13 @@
+// Copyright (c) Mainflux
+// SPDX-License-Identifier: Apache-2.0
+
+// Package mfxkit embeds the sources of the mfxkit service, which serve as the
+// template of the services created by the scaffolding generator.
+package mfxkit
+
+import "embed"
+
+// Template contains the service package, its command and the Docker files.
+//
+//go:embed mfxkit cmd/mfxkit/main.go docker/Dockerfile docker/docker-compose.yml docker/.env
+var Template embed.FS